@@ -1,6 +1,7 @@
 package database
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"os"
@@ -45,3 +46,53 @@ func Connect(args DatabaseConnectProps) *Queries {
 
 	return New(db)
 }
+
+func (q *Queries) ExecTx(ctx context.Context, fn func(*Queries) error) error {
+	db, ok := q.db.(*sql.DB)
+	if !ok {
+		// Already running inside a transaction
+		return fn(q)
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	err = fn(q.WithTx(tx))
+	if err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			return fmt.Errorf("%s: rollback failed: %s", err.Error(), rollbackErr.Error())
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// TryAdvisoryLock runs fn while holding the session advisory lock on key, so
+// only one server runs it at a time. It reports false without running fn
+// when another session holds the lock.
+func (q *Queries) TryAdvisoryLock(ctx context.Context, key int64, fn func() error) (bool, error) {
+	db, ok := q.db.(*sql.DB)
+	if !ok {
+		return false, fmt.Errorf("Advisory locks cannot be taken inside a transaction")
+	}
+
+	// The lock belongs to the session, so it is taken and released on the
+	// same connection
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Close()
+
+	var locked bool
+	err = conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", key).Scan(&locked)
+	if err != nil || locked == false {
+		return false, err
+	}
+	defer conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", key)
+
+	return true, fn()
+}
@@ -7,12 +7,144 @@ package database
 import (
 	"database/sql"
 	"database/sql/driver"
+	"encoding/json"
 	"fmt"
 	"time"
 
 	"github.com/tabbed/pqtype"
 )
 
+type DubbingCacheKind string
+
+const (
+	DubbingCacheKindTRANSLATION DubbingCacheKind = "TRANSLATION"
+	DubbingCacheKindSPEECH      DubbingCacheKind = "SPEECH"
+)
+
+func (e *DubbingCacheKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = DubbingCacheKind(s)
+	case string:
+		*e = DubbingCacheKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for DubbingCacheKind: %T", src)
+	}
+	return nil
+}
+
+type NullDubbingCacheKind struct {
+	DubbingCacheKind DubbingCacheKind
+	Valid            bool // Valid is true if DubbingCacheKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullDubbingCacheKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.DubbingCacheKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.DubbingCacheKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullDubbingCacheKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.DubbingCacheKind), nil
+}
+
+type LoudnessTarget string
+
+const (
+	LoudnessTargetBROADCAST LoudnessTarget = "BROADCAST"
+	LoudnessTargetYOUTUBE   LoudnessTarget = "YOUTUBE"
+	LoudnessTargetPODCAST   LoudnessTarget = "PODCAST"
+)
+
+func (e *LoudnessTarget) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = LoudnessTarget(s)
+	case string:
+		*e = LoudnessTarget(s)
+	default:
+		return fmt.Errorf("unsupported scan type for LoudnessTarget: %T", src)
+	}
+	return nil
+}
+
+type NullLoudnessTarget struct {
+	LoudnessTarget LoudnessTarget
+	Valid          bool // Valid is true if LoudnessTarget is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullLoudnessTarget) Scan(value interface{}) error {
+	if value == nil {
+		ns.LoudnessTarget, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.LoudnessTarget.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullLoudnessTarget) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.LoudnessTarget), nil
+}
+
+type MediaAssetKind string
+
+const (
+	MediaAssetKindSOURCE    MediaAssetKind = "SOURCE"
+	MediaAssetKindSTEM      MediaAssetKind = "STEM"
+	MediaAssetKindDUB       MediaAssetKind = "DUB"
+	MediaAssetKindSUBTITLE  MediaAssetKind = "SUBTITLE"
+	MediaAssetKindPREVIEW   MediaAssetKind = "PREVIEW"
+	MediaAssetKindTHUMBNAIL MediaAssetKind = "THUMBNAIL"
+)
+
+func (e *MediaAssetKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = MediaAssetKind(s)
+	case string:
+		*e = MediaAssetKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for MediaAssetKind: %T", src)
+	}
+	return nil
+}
+
+type NullMediaAssetKind struct {
+	MediaAssetKind MediaAssetKind
+	Valid          bool // Valid is true if MediaAssetKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullMediaAssetKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.MediaAssetKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.MediaAssetKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullMediaAssetKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.MediaAssetKind), nil
+}
+
 type MembershipType string
 
 const (
@@ -56,6 +188,140 @@ func (ns NullMembershipType) Value() (driver.Value, error) {
 	return string(ns.MembershipType), nil
 }
 
+type OutputProfile string
+
+const (
+	OutputProfileSOURCE      OutputProfile = "SOURCE"
+	OutputProfileHD720P      OutputProfile = "HD_720P"
+	OutputProfileHD1080P     OutputProfile = "HD_1080P"
+	OutputProfileUHD4K       OutputProfile = "UHD_4K"
+	OutputProfileVERTICAL916 OutputProfile = "VERTICAL_9_16"
+	OutputProfileWEBMVP9     OutputProfile = "WEBM_VP9"
+	OutputProfilePRORESPROXY OutputProfile = "PRORES_PROXY"
+)
+
+func (e *OutputProfile) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = OutputProfile(s)
+	case string:
+		*e = OutputProfile(s)
+	default:
+		return fmt.Errorf("unsupported scan type for OutputProfile: %T", src)
+	}
+	return nil
+}
+
+type NullOutputProfile struct {
+	OutputProfile OutputProfile
+	Valid         bool // Valid is true if OutputProfile is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullOutputProfile) Scan(value interface{}) error {
+	if value == nil {
+		ns.OutputProfile, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.OutputProfile.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullOutputProfile) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.OutputProfile), nil
+}
+
+type ReservationStatus string
+
+const (
+	ReservationStatusHELD     ReservationStatus = "HELD"
+	ReservationStatusCAPTURED ReservationStatus = "CAPTURED"
+	ReservationStatusRELEASED ReservationStatus = "RELEASED"
+)
+
+func (e *ReservationStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ReservationStatus(s)
+	case string:
+		*e = ReservationStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ReservationStatus: %T", src)
+	}
+	return nil
+}
+
+type NullReservationStatus struct {
+	ReservationStatus ReservationStatus
+	Valid             bool // Valid is true if ReservationStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullReservationStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.ReservationStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ReservationStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullReservationStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ReservationStatus), nil
+}
+
+type StripeEventStatus string
+
+const (
+	StripeEventStatusRECEIVED  StripeEventStatus = "RECEIVED"
+	StripeEventStatusPROCESSED StripeEventStatus = "PROCESSED"
+	StripeEventStatusIGNORED   StripeEventStatus = "IGNORED"
+	StripeEventStatusFAILED    StripeEventStatus = "FAILED"
+)
+
+func (e *StripeEventStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = StripeEventStatus(s)
+	case string:
+		*e = StripeEventStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for StripeEventStatus: %T", src)
+	}
+	return nil
+}
+
+type NullStripeEventStatus struct {
+	StripeEventStatus StripeEventStatus
+	Valid             bool // Valid is true if StripeEventStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullStripeEventStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.StripeEventStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.StripeEventStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullStripeEventStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.StripeEventStatus), nil
+}
+
 type TeamType string
 
 const (
@@ -98,29 +364,251 @@ func (ns NullTeamType) Value() (driver.Value, error) {
 	return string(ns.TeamType), nil
 }
 
+type TranscriptionModel string
+
+const (
+	TranscriptionModelTINY    TranscriptionModel = "TINY"
+	TranscriptionModelBASE    TranscriptionModel = "BASE"
+	TranscriptionModelSMALL   TranscriptionModel = "SMALL"
+	TranscriptionModelMEDIUM  TranscriptionModel = "MEDIUM"
+	TranscriptionModelLARGEV2 TranscriptionModel = "LARGE_V2"
+)
+
+func (e *TranscriptionModel) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TranscriptionModel(s)
+	case string:
+		*e = TranscriptionModel(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TranscriptionModel: %T", src)
+	}
+	return nil
+}
+
+type NullTranscriptionModel struct {
+	TranscriptionModel TranscriptionModel
+	Valid              bool // Valid is true if TranscriptionModel is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTranscriptionModel) Scan(value interface{}) error {
+	if value == nil {
+		ns.TranscriptionModel, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TranscriptionModel.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTranscriptionModel) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TranscriptionModel), nil
+}
+
+type TranslationQaMode string
+
+const (
+	TranslationQaModeOFF         TranslationQaMode = "OFF"
+	TranslationQaModeFLAG        TranslationQaMode = "FLAG"
+	TranslationQaModeRETRANSLATE TranslationQaMode = "RETRANSLATE"
+)
+
+func (e *TranslationQaMode) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TranslationQaMode(s)
+	case string:
+		*e = TranslationQaMode(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TranslationQaMode: %T", src)
+	}
+	return nil
+}
+
+type NullTranslationQaMode struct {
+	TranslationQaMode TranslationQaMode
+	Valid             bool // Valid is true if TranslationQaMode is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTranslationQaMode) Scan(value interface{}) error {
+	if value == nil {
+		ns.TranslationQaMode, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TranslationQaMode.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTranslationQaMode) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TranslationQaMode), nil
+}
+
+type UploadStatus string
+
+const (
+	UploadStatusPENDING   UploadStatus = "PENDING"
+	UploadStatusCOMPLETED UploadStatus = "COMPLETED"
+	UploadStatusABORTED   UploadStatus = "ABORTED"
+)
+
+func (e *UploadStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = UploadStatus(s)
+	case string:
+		*e = UploadStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for UploadStatus: %T", src)
+	}
+	return nil
+}
+
+type NullUploadStatus struct {
+	UploadStatus UploadStatus
+	Valid        bool // Valid is true if UploadStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullUploadStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.UploadStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.UploadStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullUploadStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.UploadStatus), nil
+}
+
+type CreditReservation struct {
+	ID                     int64
+	TeamID                 int64
+	TransformationID       sql.NullInt64
+	ReservedCredits        int64
+	ReservedOverageCredits int64
+	CapturedCredits        int64
+	Status                 ReservationStatus
+	OverageReported        bool
+	Created                time.Time
+	Updated                time.Time
+}
+
+type DubbingCacheEntry struct {
+	ID         int64
+	CacheKey   string
+	Kind       DubbingCacheKind
+	StorageKey string
+	Hits       int64
+	Created    time.Time
+	LastUsed   time.Time
+}
+
+type MediaAsset struct {
+	ID               int64
+	ProjectID        int64
+	TransformationID sql.NullInt64
+	Kind             MediaAssetKind
+	StorageKey       string
+	SizeBytes        int64
+	Checksum         string
+	DurationSeconds  sql.NullFloat64
+	Codec            sql.NullString
+	Created          time.Time
+}
+
 type Project struct {
-	ID          int64
-	TeamID      int64
-	Title       string
-	SourceMedia string
-	Created     time.Time
+	ID                      int64
+	TeamID                  int64
+	Title                   string
+	SourceMedia             string
+	OutputProfile           OutputProfile
+	LoudnessTarget          LoudnessTarget
+	SourceLanguage          sql.NullString
+	TranscriptionPrompt     sql.NullString
+	TranscriptionVocabulary []string
+	TranscriptionModel      TranscriptionModel
+	Created                 time.Time
+}
+
+type RetentionPolicy struct {
+	ID            int64
+	TeamID        int64
+	Kind          MediaAssetKind
+	RetentionDays int64
+	Created       time.Time
+}
+
+type SourceMediaInfo struct {
+	ID              int64
+	ProjectID       int64
+	Container       string
+	DurationSeconds float64
+	VideoCodec      string
+	Width           int64
+	Height          int64
+	FrameRate       float64
+	AudioCodec      string
+	AudioChannels   int64
+	ChannelLayout   string
+	SampleRate      int64
+	LoudnessLufs    sql.NullFloat64
+	Streams         json.RawMessage
+	Created         time.Time
+}
+
+type StripeEvent struct {
+	ID            int64
+	StripeEventID string
+	EventType     string
+	Payload       string
+	Status        StripeEventStatus
+	Attempts      int64
+	LastError     sql.NullString
+	Created       time.Time
+	Processed     sql.NullTime
 }
 
 type SubscriptionPlan struct {
 	ID                   int64
 	TeamID               int64
 	StripeSubscriptionID sql.NullString
+	StripePriceID        sql.NullString
+	PlanTier             string
+	IncludedCredits      int64
+	SubscriptionStatus   string
+	PastDueSince         sql.NullTime
 	RemainingCredits     int64
+	OverageEnabled       bool
+	OverageSpendCapUsd   int64
+	OverageCreditsUsed   int64
 	Created              time.Time
 }
 
 type Team struct {
-	ID               int64
-	Slug             string
-	Name             string
-	StripeCustomerID sql.NullString
-	TeamType         TeamType
-	Created          time.Time
+	ID                   int64
+	Slug                 string
+	Name                 string
+	StripeCustomerID     sql.NullString
+	TeamType             TeamType
+	DubbingCacheEnabled  bool
+	DefaultOutputProfile OutputProfile
+	TranslationQaMode    TranslationQaMode
+	Created              time.Time
 }
 
 type TeamInvite struct {
@@ -151,6 +639,48 @@ type Transformation struct {
 	Created        time.Time
 }
 
+type TranslationModel struct {
+	ID             int64
+	TeamID         int64
+	SourceLanguage string
+	TargetLanguage string
+	Position       int64
+	Provider       string
+	Model          string
+	Temperature    sql.NullFloat64
+	MaxTokens      sql.NullInt64
+	JsonResponse   bool
+	Created        time.Time
+}
+
+type TranslationQaSegment struct {
+	ID               int64
+	TransformationID int64
+	SegmentID        int64
+	SourceText       string
+	TranslatedText   string
+	BackTranslation  string
+	Similarity       float64
+	LengthFit        float64
+	Score            float64
+	Flagged          bool
+	Retranslated     bool
+	Created          time.Time
+}
+
+type UploadSession struct {
+	ID              int64
+	TeamID          int64
+	UploadKey       string
+	StorageUploadID string
+	FileName        string
+	FileSize        int64
+	PartSize        int64
+	Status          UploadStatus
+	Created         time.Time
+	Completed       sql.NullTime
+}
+
 type Userinfo struct {
 	ID       int64
 	Email    string
@@ -9,7 +9,7 @@ SELECT * FROM userinfo WHERE id = $1 LIMIT 1;
 
 
 -- name: CreateTeam :one
-INSERT INTO team (slug, name, team_type, created) VALUES ($1, $2, $3, clock_timestamp()) RETURNING *;
+INSERT INTO team (slug, name, team_type, dubbing_cache_enabled, default_output_profile, translation_qa_mode, created) VALUES ($1, $2, $3, TRUE, 'HD_720P', 'OFF', clock_timestamp()) RETURNING *;
 
 -- name: GetTeamById :one
 SELECT * FROM team WHERE id = $1 LIMIT 1;
@@ -56,8 +56,8 @@ SELECT * FROM team_invite WHERE invitee_email = $1 ORDER BY created;
 
 -- name: CreateSubscription :one
 INSERT INTO subscription_plan
-(team_id, stripe_subscription_id, remaining_credits, created)
-VALUES ($1, $2, $3, clock_timestamp()) RETURNING *;
+(team_id, stripe_subscription_id, plan_tier, included_credits, subscription_status, remaining_credits, overage_enabled, overage_spend_cap_usd, overage_credits_used, created)
+VALUES ($1, $2, 'FREE', 0, 'inactive', $3, false, 0, 0, clock_timestamp()) RETURNING *;
 
 
 -- name: GetSubscriptionsByTeamId :many
@@ -78,12 +78,36 @@ UPDATE subscription_plan SET remaining_credits = remaining_credits + $2 WHERE te
 -- name: SetSubscriptionStripeIdByTeamId :one
 UPDATE subscription_plan SET stripe_subscription_id = $2 WHERE team_id = $1 RETURNING *;
 
+-- name: AdjustSubscriptionCreditsByTeamId :one
+UPDATE subscription_plan SET remaining_credits = GREATEST(remaining_credits + $2, 0) WHERE team_id = $1 RETURNING *;
+
+-- name: SetSubscriptionPlanTierByTeamId :one
+UPDATE subscription_plan SET stripe_price_id = $2, plan_tier = $3, included_credits = $4 WHERE team_id = $1 RETURNING *;
+
+-- name: SetSubscriptionStatusByTeamId :one
+UPDATE subscription_plan SET subscription_status = $2, past_due_since = $3 WHERE team_id = $1 RETURNING *;
+
 -- name: SetRemainingCreditsById :one
 UPDATE subscription_plan SET remaining_credits = $2 WHERE id = $1 RETURNING *;
 
+-- name: GetSubscriptionByTeamId :one
+SELECT * FROM subscription_plan WHERE team_id = $1 LIMIT 1;
+
+-- name: GetSubscriptionByTeamIdForUpdate :one
+SELECT * FROM subscription_plan WHERE team_id = $1 LIMIT 1 FOR UPDATE;
+
+-- name: SetCreditBalancesById :one
+UPDATE subscription_plan SET remaining_credits = $2, overage_credits_used = $3 WHERE id = $1 RETURNING *;
+
+-- name: SetOverageSettingsByTeamId :one
+UPDATE subscription_plan SET overage_enabled = $2, overage_spend_cap_usd = $3 WHERE team_id = $1 RETURNING *;
+
+-- name: ResetOverageCreditsUsedByTeamId :one
+UPDATE subscription_plan SET overage_credits_used = 0 WHERE team_id = $1 RETURNING *;
+
 
 -- name: CreateProject :one
-INSERT INTO project (team_id, title, source_media, created) VALUES ($1, $2, $3, clock_timestamp()) RETURNING *;
+INSERT INTO project (team_id, title, source_media, output_profile, loudness_target, source_language, transcription_prompt, transcription_vocabulary, transcription_model, created) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, clock_timestamp()) RETURNING *;
 
 -- name: GetProjectById :one
 SELECT * FROM project WHERE id = $1 LIMIT 1;
@@ -97,6 +121,9 @@ SELECT * FROM project WHERE team_id = $1 ORDER BY created;
 -- name: UpdateProjectSourceMedia :one
 UPDATE project SET source_media = $2 WHERE id = $1 RETURNING *;
 
+-- name: UpdateProjectTranscriptionSettings :one
+UPDATE project SET source_language = $2, transcription_prompt = $3, transcription_vocabulary = $4, transcription_model = $5 WHERE id = $1 RETURNING *;
+
 -- name: DeleteProjectById :one
 DELETE FROM project WHERE id = $1 RETURNING *;
 
@@ -115,9 +142,15 @@ UPDATE transformation SET target_media = $2 WHERE id = $1 RETURNING *;
 -- name: UpdateTransformationStatusById :one
 UPDATE transformation SET status = $2 WHERE id = $1 RETURNING *;
 
+-- name: StartTransformationProcessingById :one
+UPDATE transformation SET status = 'processing' WHERE id = $1 AND status <> 'processing' RETURNING *;
+
 -- name: UpdateTransformationProgressById :one
 UPDATE transformation SET progress = $2 WHERE id = $1 RETURNING *;
 
+-- name: UpdateTranscriptLanguageById :one
+UPDATE transformation SET transcript = $2, target_language = $3 WHERE id = $1 RETURNING *;
+
 -- name: GetTransformationById :one
 SELECT * FROM transformation WHERE id = $1 LIMIT 1;
 
@@ -136,3 +169,219 @@ SELECT * FROM transformation WHERE project_id = $1 AND target_language = $2 LIMI
 -- name: DeleteTransformationById :one
 DELETE FROM transformation WHERE id = $1 RETURNING *;
 
+
+
+-- name: CreateCreditReservation :one
+INSERT INTO credit_reservation
+(team_id, transformation_id, reserved_credits, reserved_overage_credits, captured_credits, status, overage_reported, created, updated)
+VALUES ($1, $2, $3, $4, 0, 'HELD', FALSE, clock_timestamp(), clock_timestamp()) RETURNING *;
+
+-- name: GetCreditReservationById :one
+SELECT * FROM credit_reservation WHERE id = $1 LIMIT 1;
+
+-- name: GetCreditReservationByTransformationId :one
+SELECT * FROM credit_reservation WHERE transformation_id = $1 LIMIT 1;
+
+-- name: GetStaleCreditReservations :many
+SELECT * FROM credit_reservation WHERE status = 'HELD' AND created < $1 ORDER BY created;
+
+-- name: GetHeldCreditsByTeamId :one
+SELECT COALESCE(SUM(reserved_credits), 0)::BIGINT FROM credit_reservation WHERE team_id = $1 AND status = 'HELD';
+
+-- name: CaptureCreditReservationById :one
+UPDATE credit_reservation SET status = 'CAPTURED', captured_credits = $2, updated = clock_timestamp()
+WHERE id = $1 AND status = 'HELD' RETURNING *;
+
+-- name: GetUnreportedOverageReservations :many
+SELECT * FROM credit_reservation
+WHERE status = 'CAPTURED' AND overage_reported = FALSE AND captured_credits > reserved_credits - reserved_overage_credits
+ORDER BY updated;
+
+-- name: SetCreditReservationOverageReportedById :exec
+UPDATE credit_reservation SET overage_reported = TRUE, updated = clock_timestamp() WHERE id = $1;
+
+-- name: ReleaseCreditReservationById :one
+UPDATE credit_reservation SET status = 'RELEASED', captured_credits = 0, updated = clock_timestamp()
+WHERE id = $1 AND status = 'HELD' RETURNING *;
+
+
+-- name: CreateStripeEvent :exec
+INSERT INTO stripe_event
+(stripe_event_id, event_type, payload, status, attempts, created)
+VALUES ($1, $2, $3, 'RECEIVED', 0, clock_timestamp())
+ON CONFLICT (stripe_event_id) DO NOTHING;
+
+-- name: GetStripeEventByStripeEventId :one
+SELECT * FROM stripe_event WHERE stripe_event_id = $1 LIMIT 1;
+
+-- name: GetStripeEventByStripeEventIdForUpdate :one
+SELECT * FROM stripe_event WHERE stripe_event_id = $1 LIMIT 1 FOR UPDATE;
+
+-- name: GetStripeEventsByStatus :many
+SELECT * FROM stripe_event WHERE status = $1 ORDER BY created;
+
+-- name: SetStripeEventProcessedById :one
+UPDATE stripe_event SET status = $2, attempts = attempts + 1, last_error = NULL, processed = clock_timestamp()
+WHERE id = $1 RETURNING *;
+
+-- name: SetStripeEventFailedById :one
+UPDATE stripe_event SET status = 'FAILED', attempts = attempts + 1, last_error = $2
+WHERE id = $1 RETURNING *;
+
+
+-- name: CreateUploadSession :one
+INSERT INTO upload_session
+(team_id, upload_key, storage_upload_id, file_name, file_size, part_size, status, created)
+VALUES ($1, $2, $3, $4, $5, $6, 'PENDING', clock_timestamp()) RETURNING *;
+
+-- name: GetUploadSessionByIdTeamId :one
+SELECT * FROM upload_session WHERE id = $1 AND team_id = $2 LIMIT 1;
+
+-- name: CompleteUploadSessionById :one
+UPDATE upload_session SET status = 'COMPLETED', completed = clock_timestamp()
+WHERE id = $1 AND status = 'PENDING' RETURNING *;
+
+-- name: AbortUploadSessionById :one
+UPDATE upload_session SET status = 'ABORTED', completed = clock_timestamp()
+WHERE id = $1 AND status = 'PENDING' RETURNING *;
+
+
+-- name: CreateMediaAsset :one
+INSERT INTO media_asset
+(project_id, transformation_id, kind, storage_key, size_bytes, checksum, duration_seconds, codec, created)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, clock_timestamp()) RETURNING *;
+
+-- name: GetMediaAssetsByProjectId :many
+SELECT * FROM media_asset WHERE project_id = $1 ORDER BY created;
+
+-- name: GetMediaAssetsByTransformationId :many
+SELECT * FROM media_asset WHERE transformation_id = $1 ORDER BY created;
+
+-- name: GetMediaAssetByTransformationIdKind :one
+SELECT * FROM media_asset WHERE transformation_id = $1 AND kind = $2 ORDER BY created DESC LIMIT 1;
+
+-- name: GetMediaAssetByProjectIdKind :one
+SELECT * FROM media_asset WHERE project_id = $1 AND kind = $2 ORDER BY created DESC LIMIT 1;
+
+-- name: DeleteMediaAssetById :one
+DELETE FROM media_asset WHERE id = $1 RETURNING *;
+
+-- name: CreateSourceMediaInfo :one
+INSERT INTO source_media_info
+(project_id, container, duration_seconds, video_codec, width, height, frame_rate, audio_codec, audio_channels, channel_layout, sample_rate, streams, created)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, clock_timestamp()) RETURNING *;
+
+-- name: GetSourceMediaInfoByProjectId :one
+SELECT * FROM source_media_info WHERE project_id = $1 LIMIT 1;
+
+-- name: SetSourceMediaInfoLoudnessByProjectId :one
+UPDATE source_media_info SET loudness_lufs = $2 WHERE project_id = $1 RETURNING *;
+
+-- name: GetMediaAssetStorageKeys :many
+SELECT storage_key FROM media_asset;
+
+-- name: GetSourceTransformationsWithoutMediaAssets :many
+SELECT transformation.id, transformation.project_id, transformation.target_media, project.source_media FROM transformation
+JOIN project ON project.id = transformation.project_id
+WHERE transformation.is_source = TRUE AND project.source_media != '' AND (
+  NOT EXISTS (SELECT 1 FROM media_asset WHERE media_asset.project_id = project.id AND media_asset.kind = 'SOURCE')
+  OR NOT EXISTS (SELECT 1 FROM media_asset WHERE media_asset.transformation_id = transformation.id AND media_asset.kind = 'STEM')
+);
+
+-- name: GetDubbedTransformationsWithoutMediaAssets :many
+SELECT transformation.id, transformation.project_id, transformation.target_media FROM transformation
+WHERE transformation.is_source = FALSE AND transformation.target_media != ''
+  AND NOT EXISTS (SELECT 1 FROM media_asset WHERE media_asset.transformation_id = transformation.id AND media_asset.kind = 'DUB')
+  AND NOT EXISTS (SELECT 1 FROM media_asset WHERE media_asset.storage_key = transformation.target_media);
+
+-- name: GetMediaAssetsWithTeamIdByKind :many
+SELECT media_asset.id, media_asset.storage_key, media_asset.created, project.team_id FROM media_asset
+JOIN project ON project.id = media_asset.project_id
+WHERE media_asset.kind = $1;
+
+-- name: GetProjectSourceMedia :many
+SELECT source_media FROM project;
+
+-- name: GetTransformationTargetMedia :many
+SELECT target_media FROM transformation;
+
+-- name: GetUploadSessionsByStatus :many
+SELECT * FROM upload_session WHERE status = $1 ORDER BY created;
+
+
+-- name: SetRetentionPolicy :one
+INSERT INTO retention_policy
+(team_id, kind, retention_days, created)
+VALUES ($1, $2, $3, clock_timestamp())
+ON CONFLICT (team_id, kind) DO UPDATE SET retention_days = EXCLUDED.retention_days
+RETURNING *;
+
+-- name: GetRetentionPoliciesByKind :many
+SELECT * FROM retention_policy WHERE kind = $1;
+
+-- name: GetRetentionPoliciesByTeamId :many
+SELECT * FROM retention_policy WHERE team_id = $1 ORDER BY kind;
+
+
+-- name: SetTeamDubbingCacheEnabledById :one
+UPDATE team SET dubbing_cache_enabled = $2 WHERE id = $1 RETURNING *;
+
+-- name: SetTeamDefaultOutputProfileById :one
+UPDATE team SET default_output_profile = $2 WHERE id = $1 RETURNING *;
+
+-- name: SetTeamTranslationQaModeById :one
+UPDATE team SET translation_qa_mode = $2 WHERE id = $1 RETURNING *;
+
+-- name: GetDubbingCacheEntryByCacheKey :one
+SELECT * FROM dubbing_cache_entry WHERE cache_key = $1 LIMIT 1;
+
+-- name: CreateDubbingCacheEntry :exec
+INSERT INTO dubbing_cache_entry
+(cache_key, kind, storage_key, hits, created, last_used)
+VALUES ($1, $2, $3, 0, clock_timestamp(), clock_timestamp())
+ON CONFLICT (cache_key) DO NOTHING;
+
+-- name: RecordDubbingCacheHitById :exec
+UPDATE dubbing_cache_entry SET hits = hits + 1, last_used = clock_timestamp() WHERE id = $1;
+
+-- name: GetDubbingCacheEntryStorageKeys :many
+SELECT storage_key FROM dubbing_cache_entry;
+
+-- name: GetDubbingCacheEntriesUnusedSince :many
+SELECT * FROM dubbing_cache_entry WHERE last_used < $1;
+
+-- name: DeleteDubbingCacheEntryById :exec
+DELETE FROM dubbing_cache_entry WHERE id = $1;
+
+-- name: GetDubbingCacheStats :many
+SELECT kind, COUNT(*) AS entries, SUM(hits)::BIGINT AS hits FROM dubbing_cache_entry GROUP BY kind ORDER BY kind;
+
+
+-- name: CreateTranslationModel :one
+INSERT INTO translation_model
+(team_id, source_language, target_language, position, provider, model, temperature, max_tokens, json_response, created)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, clock_timestamp())
+RETURNING *;
+
+-- name: DeleteTranslationModelsByTeamIdLanguages :exec
+DELETE FROM translation_model WHERE team_id = $1 AND source_language = $2 AND target_language = $3;
+
+-- name: GetTranslationModelsByTeamId :many
+SELECT * FROM translation_model WHERE team_id = $1 ORDER BY source_language, target_language, position;
+
+
+-- name: CreateTranslationQaSegment :one
+INSERT INTO translation_qa_segment
+(transformation_id, segment_id, source_text, translated_text, back_translation, similarity, length_fit, score, flagged, retranslated, created)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, clock_timestamp())
+ON CONFLICT (transformation_id, segment_id) DO UPDATE SET
+source_text = EXCLUDED.source_text, translated_text = EXCLUDED.translated_text, back_translation = EXCLUDED.back_translation,
+similarity = EXCLUDED.similarity, length_fit = EXCLUDED.length_fit, score = EXCLUDED.score,
+flagged = EXCLUDED.flagged, retranslated = EXCLUDED.retranslated, created = EXCLUDED.created
+RETURNING *;
+
+-- name: DeleteTranslationQaSegmentsByTransformationId :exec
+DELETE FROM translation_qa_segment WHERE transformation_id = $1;
+
+-- name: GetTranslationQaSegmentsByTransformationId :many
+SELECT * FROM translation_qa_segment WHERE transformation_id = $1 ORDER BY segment_id;
@@ -8,12 +8,38 @@ package database
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"time"
 
+	"github.com/lib/pq"
 	"github.com/tabbed/pqtype"
 )
 
+const abortUploadSessionById = `-- name: AbortUploadSessionById :one
+UPDATE upload_session SET status = 'ABORTED', completed = clock_timestamp()
+WHERE id = $1 AND status = 'PENDING' RETURNING id, team_id, upload_key, storage_upload_id, file_name, file_size, part_size, status, created, completed
+`
+
+func (q *Queries) AbortUploadSessionById(ctx context.Context, id int64) (UploadSession, error) {
+	row := q.db.QueryRowContext(ctx, abortUploadSessionById, id)
+	var i UploadSession
+	err := row.Scan(
+		&i.ID,
+		&i.TeamID,
+		&i.UploadKey,
+		&i.StorageUploadID,
+		&i.FileName,
+		&i.FileSize,
+		&i.PartSize,
+		&i.Status,
+		&i.Created,
+		&i.Completed,
+	)
+	return i, err
+}
+
 const addSubscriptionCreditsByTeamId = `-- name: AddSubscriptionCreditsByTeamId :one
-UPDATE subscription_plan SET remaining_credits = remaining_credits + $2 WHERE team_id = $1 RETURNING id, team_id, stripe_subscription_id, remaining_credits, created
+UPDATE subscription_plan SET remaining_credits = remaining_credits + $2 WHERE team_id = $1 RETURNING id, team_id, stripe_subscription_id, stripe_price_id, plan_tier, included_credits, subscription_status, past_due_since, remaining_credits, overage_enabled, overage_spend_cap_usd, overage_credits_used, created
 `
 
 type AddSubscriptionCreditsByTeamIdParams struct {
@@ -28,7 +54,15 @@ func (q *Queries) AddSubscriptionCreditsByTeamId(ctx context.Context, arg AddSub
 		&i.ID,
 		&i.TeamID,
 		&i.StripeSubscriptionID,
+		&i.StripePriceID,
+		&i.PlanTier,
+		&i.IncludedCredits,
+		&i.SubscriptionStatus,
+		&i.PastDueSince,
 		&i.RemainingCredits,
+		&i.OverageEnabled,
+		&i.OverageSpendCapUsd,
+		&i.OverageCreditsUsed,
 		&i.Created,
 	)
 	return i, err
@@ -102,33 +136,309 @@ func (q *Queries) AddUser(ctx context.Context, arg AddUserParams) (Userinfo, err
 	return i, err
 }
 
+const adjustSubscriptionCreditsByTeamId = `-- name: AdjustSubscriptionCreditsByTeamId :one
+UPDATE subscription_plan SET remaining_credits = GREATEST(remaining_credits + $2, 0) WHERE team_id = $1 RETURNING id, team_id, stripe_subscription_id, stripe_price_id, plan_tier, included_credits, subscription_status, past_due_since, remaining_credits, overage_enabled, overage_spend_cap_usd, overage_credits_used, created
+`
+
+type AdjustSubscriptionCreditsByTeamIdParams struct {
+	TeamID           int64
+	RemainingCredits int64
+}
+
+func (q *Queries) AdjustSubscriptionCreditsByTeamId(ctx context.Context, arg AdjustSubscriptionCreditsByTeamIdParams) (SubscriptionPlan, error) {
+	row := q.db.QueryRowContext(ctx, adjustSubscriptionCreditsByTeamId, arg.TeamID, arg.RemainingCredits)
+	var i SubscriptionPlan
+	err := row.Scan(
+		&i.ID,
+		&i.TeamID,
+		&i.StripeSubscriptionID,
+		&i.StripePriceID,
+		&i.PlanTier,
+		&i.IncludedCredits,
+		&i.SubscriptionStatus,
+		&i.PastDueSince,
+		&i.RemainingCredits,
+		&i.OverageEnabled,
+		&i.OverageSpendCapUsd,
+		&i.OverageCreditsUsed,
+		&i.Created,
+	)
+	return i, err
+}
+
+const captureCreditReservationById = `-- name: CaptureCreditReservationById :one
+UPDATE credit_reservation SET status = 'CAPTURED', captured_credits = $2, updated = clock_timestamp()
+WHERE id = $1 AND status = 'HELD' RETURNING id, team_id, transformation_id, reserved_credits, reserved_overage_credits, captured_credits, status, overage_reported, created, updated
+`
+
+type CaptureCreditReservationByIdParams struct {
+	ID              int64
+	CapturedCredits int64
+}
+
+func (q *Queries) CaptureCreditReservationById(ctx context.Context, arg CaptureCreditReservationByIdParams) (CreditReservation, error) {
+	row := q.db.QueryRowContext(ctx, captureCreditReservationById, arg.ID, arg.CapturedCredits)
+	var i CreditReservation
+	err := row.Scan(
+		&i.ID,
+		&i.TeamID,
+		&i.TransformationID,
+		&i.ReservedCredits,
+		&i.ReservedOverageCredits,
+		&i.CapturedCredits,
+		&i.Status,
+		&i.OverageReported,
+		&i.Created,
+		&i.Updated,
+	)
+	return i, err
+}
+
+const completeUploadSessionById = `-- name: CompleteUploadSessionById :one
+UPDATE upload_session SET status = 'COMPLETED', completed = clock_timestamp()
+WHERE id = $1 AND status = 'PENDING' RETURNING id, team_id, upload_key, storage_upload_id, file_name, file_size, part_size, status, created, completed
+`
+
+func (q *Queries) CompleteUploadSessionById(ctx context.Context, id int64) (UploadSession, error) {
+	row := q.db.QueryRowContext(ctx, completeUploadSessionById, id)
+	var i UploadSession
+	err := row.Scan(
+		&i.ID,
+		&i.TeamID,
+		&i.UploadKey,
+		&i.StorageUploadID,
+		&i.FileName,
+		&i.FileSize,
+		&i.PartSize,
+		&i.Status,
+		&i.Created,
+		&i.Completed,
+	)
+	return i, err
+}
+
+const createCreditReservation = `-- name: CreateCreditReservation :one
+INSERT INTO credit_reservation
+(team_id, transformation_id, reserved_credits, reserved_overage_credits, captured_credits, status, overage_reported, created, updated)
+VALUES ($1, $2, $3, $4, 0, 'HELD', FALSE, clock_timestamp(), clock_timestamp()) RETURNING id, team_id, transformation_id, reserved_credits, reserved_overage_credits, captured_credits, status, overage_reported, created, updated
+`
+
+type CreateCreditReservationParams struct {
+	TeamID                 int64
+	TransformationID       sql.NullInt64
+	ReservedCredits        int64
+	ReservedOverageCredits int64
+}
+
+func (q *Queries) CreateCreditReservation(ctx context.Context, arg CreateCreditReservationParams) (CreditReservation, error) {
+	row := q.db.QueryRowContext(ctx, createCreditReservation,
+		arg.TeamID,
+		arg.TransformationID,
+		arg.ReservedCredits,
+		arg.ReservedOverageCredits,
+	)
+	var i CreditReservation
+	err := row.Scan(
+		&i.ID,
+		&i.TeamID,
+		&i.TransformationID,
+		&i.ReservedCredits,
+		&i.ReservedOverageCredits,
+		&i.CapturedCredits,
+		&i.Status,
+		&i.OverageReported,
+		&i.Created,
+		&i.Updated,
+	)
+	return i, err
+}
+
+const createDubbingCacheEntry = `-- name: CreateDubbingCacheEntry :exec
+INSERT INTO dubbing_cache_entry
+(cache_key, kind, storage_key, hits, created, last_used)
+VALUES ($1, $2, $3, 0, clock_timestamp(), clock_timestamp())
+ON CONFLICT (cache_key) DO NOTHING
+`
+
+type CreateDubbingCacheEntryParams struct {
+	CacheKey   string
+	Kind       DubbingCacheKind
+	StorageKey string
+}
+
+func (q *Queries) CreateDubbingCacheEntry(ctx context.Context, arg CreateDubbingCacheEntryParams) error {
+	_, err := q.db.ExecContext(ctx, createDubbingCacheEntry, arg.CacheKey, arg.Kind, arg.StorageKey)
+	return err
+}
+
+const createMediaAsset = `-- name: CreateMediaAsset :one
+INSERT INTO media_asset
+(project_id, transformation_id, kind, storage_key, size_bytes, checksum, duration_seconds, codec, created)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, clock_timestamp()) RETURNING id, project_id, transformation_id, kind, storage_key, size_bytes, checksum, duration_seconds, codec, created
+`
+
+type CreateMediaAssetParams struct {
+	ProjectID        int64
+	TransformationID sql.NullInt64
+	Kind             MediaAssetKind
+	StorageKey       string
+	SizeBytes        int64
+	Checksum         string
+	DurationSeconds  sql.NullFloat64
+	Codec            sql.NullString
+}
+
+func (q *Queries) CreateMediaAsset(ctx context.Context, arg CreateMediaAssetParams) (MediaAsset, error) {
+	row := q.db.QueryRowContext(ctx, createMediaAsset,
+		arg.ProjectID,
+		arg.TransformationID,
+		arg.Kind,
+		arg.StorageKey,
+		arg.SizeBytes,
+		arg.Checksum,
+		arg.DurationSeconds,
+		arg.Codec,
+	)
+	var i MediaAsset
+	err := row.Scan(
+		&i.ID,
+		&i.ProjectID,
+		&i.TransformationID,
+		&i.Kind,
+		&i.StorageKey,
+		&i.SizeBytes,
+		&i.Checksum,
+		&i.DurationSeconds,
+		&i.Codec,
+		&i.Created,
+	)
+	return i, err
+}
+
 const createProject = `-- name: CreateProject :one
-INSERT INTO project (team_id, title, source_media, created) VALUES ($1, $2, $3, clock_timestamp()) RETURNING id, team_id, title, source_media, created
+INSERT INTO project (team_id, title, source_media, output_profile, loudness_target, source_language, transcription_prompt, transcription_vocabulary, transcription_model, created) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, clock_timestamp()) RETURNING id, team_id, title, source_media, output_profile, loudness_target, source_language, transcription_prompt, transcription_vocabulary, transcription_model, created
 `
 
 type CreateProjectParams struct {
-	TeamID      int64
-	Title       string
-	SourceMedia string
+	TeamID                  int64
+	Title                   string
+	SourceMedia             string
+	OutputProfile           OutputProfile
+	LoudnessTarget          LoudnessTarget
+	SourceLanguage          sql.NullString
+	TranscriptionPrompt     sql.NullString
+	TranscriptionVocabulary []string
+	TranscriptionModel      TranscriptionModel
 }
 
 func (q *Queries) CreateProject(ctx context.Context, arg CreateProjectParams) (Project, error) {
-	row := q.db.QueryRowContext(ctx, createProject, arg.TeamID, arg.Title, arg.SourceMedia)
+	row := q.db.QueryRowContext(ctx, createProject,
+		arg.TeamID,
+		arg.Title,
+		arg.SourceMedia,
+		arg.OutputProfile,
+		arg.LoudnessTarget,
+		arg.SourceLanguage,
+		arg.TranscriptionPrompt,
+		pq.Array(arg.TranscriptionVocabulary),
+		arg.TranscriptionModel,
+	)
 	var i Project
 	err := row.Scan(
 		&i.ID,
 		&i.TeamID,
 		&i.Title,
 		&i.SourceMedia,
+		&i.OutputProfile,
+		&i.LoudnessTarget,
+		&i.SourceLanguage,
+		&i.TranscriptionPrompt,
+		pq.Array(&i.TranscriptionVocabulary),
+		&i.TranscriptionModel,
+		&i.Created,
+	)
+	return i, err
+}
+
+const createSourceMediaInfo = `-- name: CreateSourceMediaInfo :one
+INSERT INTO source_media_info
+(project_id, container, duration_seconds, video_codec, width, height, frame_rate, audio_codec, audio_channels, channel_layout, sample_rate, streams, created)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, clock_timestamp()) RETURNING id, project_id, container, duration_seconds, video_codec, width, height, frame_rate, audio_codec, audio_channels, channel_layout, sample_rate, loudness_lufs, streams, created
+`
+
+type CreateSourceMediaInfoParams struct {
+	ProjectID       int64
+	Container       string
+	DurationSeconds float64
+	VideoCodec      string
+	Width           int64
+	Height          int64
+	FrameRate       float64
+	AudioCodec      string
+	AudioChannels   int64
+	ChannelLayout   string
+	SampleRate      int64
+	Streams         json.RawMessage
+}
+
+func (q *Queries) CreateSourceMediaInfo(ctx context.Context, arg CreateSourceMediaInfoParams) (SourceMediaInfo, error) {
+	row := q.db.QueryRowContext(ctx, createSourceMediaInfo,
+		arg.ProjectID,
+		arg.Container,
+		arg.DurationSeconds,
+		arg.VideoCodec,
+		arg.Width,
+		arg.Height,
+		arg.FrameRate,
+		arg.AudioCodec,
+		arg.AudioChannels,
+		arg.ChannelLayout,
+		arg.SampleRate,
+		arg.Streams,
+	)
+	var i SourceMediaInfo
+	err := row.Scan(
+		&i.ID,
+		&i.ProjectID,
+		&i.Container,
+		&i.DurationSeconds,
+		&i.VideoCodec,
+		&i.Width,
+		&i.Height,
+		&i.FrameRate,
+		&i.AudioCodec,
+		&i.AudioChannels,
+		&i.ChannelLayout,
+		&i.SampleRate,
+		&i.LoudnessLufs,
+		&i.Streams,
 		&i.Created,
 	)
 	return i, err
 }
 
+const createStripeEvent = `-- name: CreateStripeEvent :exec
+INSERT INTO stripe_event
+(stripe_event_id, event_type, payload, status, attempts, created)
+VALUES ($1, $2, $3, 'RECEIVED', 0, clock_timestamp())
+ON CONFLICT (stripe_event_id) DO NOTHING
+`
+
+type CreateStripeEventParams struct {
+	StripeEventID string
+	EventType     string
+	Payload       string
+}
+
+func (q *Queries) CreateStripeEvent(ctx context.Context, arg CreateStripeEventParams) error {
+	_, err := q.db.ExecContext(ctx, createStripeEvent, arg.StripeEventID, arg.EventType, arg.Payload)
+	return err
+}
+
 const createSubscription = `-- name: CreateSubscription :one
 INSERT INTO subscription_plan
-(team_id, stripe_subscription_id, remaining_credits, created)
-VALUES ($1, $2, $3, clock_timestamp()) RETURNING id, team_id, stripe_subscription_id, remaining_credits, created
+(team_id, stripe_subscription_id, plan_tier, included_credits, subscription_status, remaining_credits, overage_enabled, overage_spend_cap_usd, overage_credits_used, created)
+VALUES ($1, $2, 'FREE', 0, 'inactive', $3, false, 0, 0, clock_timestamp()) RETURNING id, team_id, stripe_subscription_id, stripe_price_id, plan_tier, included_credits, subscription_status, past_due_since, remaining_credits, overage_enabled, overage_spend_cap_usd, overage_credits_used, created
 `
 
 type CreateSubscriptionParams struct {
@@ -144,14 +454,22 @@ func (q *Queries) CreateSubscription(ctx context.Context, arg CreateSubscription
 		&i.ID,
 		&i.TeamID,
 		&i.StripeSubscriptionID,
+		&i.StripePriceID,
+		&i.PlanTier,
+		&i.IncludedCredits,
+		&i.SubscriptionStatus,
+		&i.PastDueSince,
 		&i.RemainingCredits,
+		&i.OverageEnabled,
+		&i.OverageSpendCapUsd,
+		&i.OverageCreditsUsed,
 		&i.Created,
 	)
 	return i, err
 }
 
 const createTeam = `-- name: CreateTeam :one
-INSERT INTO team (slug, name, team_type, created) VALUES ($1, $2, $3, clock_timestamp()) RETURNING id, slug, name, stripe_customer_id, team_type, created
+INSERT INTO team (slug, name, team_type, dubbing_cache_enabled, default_output_profile, translation_qa_mode, created) VALUES ($1, $2, $3, TRUE, 'HD_720P', 'OFF', clock_timestamp()) RETURNING id, slug, name, stripe_customer_id, team_type, dubbing_cache_enabled, default_output_profile, translation_qa_mode, created
 `
 
 type CreateTeamParams struct {
@@ -169,6 +487,9 @@ func (q *Queries) CreateTeam(ctx context.Context, arg CreateTeamParams) (Team, e
 		&i.Name,
 		&i.StripeCustomerID,
 		&i.TeamType,
+		&i.DubbingCacheEnabled,
+		&i.DefaultOutputProfile,
+		&i.TranslationQaMode,
 		&i.Created,
 	)
 	return i, err
@@ -215,8 +536,182 @@ func (q *Queries) CreateTransformation(ctx context.Context, arg CreateTransforma
 	return i, err
 }
 
+const createTranslationModel = `-- name: CreateTranslationModel :one
+INSERT INTO translation_model
+(team_id, source_language, target_language, position, provider, model, temperature, max_tokens, json_response, created)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, clock_timestamp())
+RETURNING id, team_id, source_language, target_language, position, provider, model, temperature, max_tokens, json_response, created
+`
+
+type CreateTranslationModelParams struct {
+	TeamID         int64
+	SourceLanguage string
+	TargetLanguage string
+	Position       int64
+	Provider       string
+	Model          string
+	Temperature    sql.NullFloat64
+	MaxTokens      sql.NullInt64
+	JsonResponse   bool
+}
+
+func (q *Queries) CreateTranslationModel(ctx context.Context, arg CreateTranslationModelParams) (TranslationModel, error) {
+	row := q.db.QueryRowContext(ctx, createTranslationModel,
+		arg.TeamID,
+		arg.SourceLanguage,
+		arg.TargetLanguage,
+		arg.Position,
+		arg.Provider,
+		arg.Model,
+		arg.Temperature,
+		arg.MaxTokens,
+		arg.JsonResponse,
+	)
+	var i TranslationModel
+	err := row.Scan(
+		&i.ID,
+		&i.TeamID,
+		&i.SourceLanguage,
+		&i.TargetLanguage,
+		&i.Position,
+		&i.Provider,
+		&i.Model,
+		&i.Temperature,
+		&i.MaxTokens,
+		&i.JsonResponse,
+		&i.Created,
+	)
+	return i, err
+}
+
+const createTranslationQaSegment = `-- name: CreateTranslationQaSegment :one
+INSERT INTO translation_qa_segment
+(transformation_id, segment_id, source_text, translated_text, back_translation, similarity, length_fit, score, flagged, retranslated, created)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, clock_timestamp())
+ON CONFLICT (transformation_id, segment_id) DO UPDATE SET
+source_text = EXCLUDED.source_text, translated_text = EXCLUDED.translated_text, back_translation = EXCLUDED.back_translation,
+similarity = EXCLUDED.similarity, length_fit = EXCLUDED.length_fit, score = EXCLUDED.score,
+flagged = EXCLUDED.flagged, retranslated = EXCLUDED.retranslated, created = EXCLUDED.created
+RETURNING id, transformation_id, segment_id, source_text, translated_text, back_translation, similarity, length_fit, score, flagged, retranslated, created
+`
+
+type CreateTranslationQaSegmentParams struct {
+	TransformationID int64
+	SegmentID        int64
+	SourceText       string
+	TranslatedText   string
+	BackTranslation  string
+	Similarity       float64
+	LengthFit        float64
+	Score            float64
+	Flagged          bool
+	Retranslated     bool
+}
+
+func (q *Queries) CreateTranslationQaSegment(ctx context.Context, arg CreateTranslationQaSegmentParams) (TranslationQaSegment, error) {
+	row := q.db.QueryRowContext(ctx, createTranslationQaSegment,
+		arg.TransformationID,
+		arg.SegmentID,
+		arg.SourceText,
+		arg.TranslatedText,
+		arg.BackTranslation,
+		arg.Similarity,
+		arg.LengthFit,
+		arg.Score,
+		arg.Flagged,
+		arg.Retranslated,
+	)
+	var i TranslationQaSegment
+	err := row.Scan(
+		&i.ID,
+		&i.TransformationID,
+		&i.SegmentID,
+		&i.SourceText,
+		&i.TranslatedText,
+		&i.BackTranslation,
+		&i.Similarity,
+		&i.LengthFit,
+		&i.Score,
+		&i.Flagged,
+		&i.Retranslated,
+		&i.Created,
+	)
+	return i, err
+}
+
+const createUploadSession = `-- name: CreateUploadSession :one
+INSERT INTO upload_session
+(team_id, upload_key, storage_upload_id, file_name, file_size, part_size, status, created)
+VALUES ($1, $2, $3, $4, $5, $6, 'PENDING', clock_timestamp()) RETURNING id, team_id, upload_key, storage_upload_id, file_name, file_size, part_size, status, created, completed
+`
+
+type CreateUploadSessionParams struct {
+	TeamID          int64
+	UploadKey       string
+	StorageUploadID string
+	FileName        string
+	FileSize        int64
+	PartSize        int64
+}
+
+func (q *Queries) CreateUploadSession(ctx context.Context, arg CreateUploadSessionParams) (UploadSession, error) {
+	row := q.db.QueryRowContext(ctx, createUploadSession,
+		arg.TeamID,
+		arg.UploadKey,
+		arg.StorageUploadID,
+		arg.FileName,
+		arg.FileSize,
+		arg.PartSize,
+	)
+	var i UploadSession
+	err := row.Scan(
+		&i.ID,
+		&i.TeamID,
+		&i.UploadKey,
+		&i.StorageUploadID,
+		&i.FileName,
+		&i.FileSize,
+		&i.PartSize,
+		&i.Status,
+		&i.Created,
+		&i.Completed,
+	)
+	return i, err
+}
+
+const deleteDubbingCacheEntryById = `-- name: DeleteDubbingCacheEntryById :exec
+DELETE FROM dubbing_cache_entry WHERE id = $1
+`
+
+func (q *Queries) DeleteDubbingCacheEntryById(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, deleteDubbingCacheEntryById, id)
+	return err
+}
+
+const deleteMediaAssetById = `-- name: DeleteMediaAssetById :one
+DELETE FROM media_asset WHERE id = $1 RETURNING id, project_id, transformation_id, kind, storage_key, size_bytes, checksum, duration_seconds, codec, created
+`
+
+func (q *Queries) DeleteMediaAssetById(ctx context.Context, id int64) (MediaAsset, error) {
+	row := q.db.QueryRowContext(ctx, deleteMediaAssetById, id)
+	var i MediaAsset
+	err := row.Scan(
+		&i.ID,
+		&i.ProjectID,
+		&i.TransformationID,
+		&i.Kind,
+		&i.StorageKey,
+		&i.SizeBytes,
+		&i.Checksum,
+		&i.DurationSeconds,
+		&i.Codec,
+		&i.Created,
+	)
+	return i, err
+}
+
 const deleteProjectById = `-- name: DeleteProjectById :one
-DELETE FROM project WHERE id = $1 RETURNING id, team_id, title, source_media, created
+DELETE FROM project WHERE id = $1 RETURNING id, team_id, title, source_media, output_profile, loudness_target, source_language, transcription_prompt, transcription_vocabulary, transcription_model, created
 `
 
 func (q *Queries) DeleteProjectById(ctx context.Context, id int64) (Project, error) {
@@ -227,6 +722,12 @@ func (q *Queries) DeleteProjectById(ctx context.Context, id int64) (Project, err
 		&i.TeamID,
 		&i.Title,
 		&i.SourceMedia,
+		&i.OutputProfile,
+		&i.LoudnessTarget,
+		&i.SourceLanguage,
+		&i.TranscriptionPrompt,
+		pq.Array(&i.TranscriptionVocabulary),
+		&i.TranscriptionModel,
 		&i.Created,
 	)
 	return i, err
@@ -270,64 +771,100 @@ func (q *Queries) DeleteTransformationById(ctx context.Context, id int64) (Trans
 	return i, err
 }
 
-const getProjectById = `-- name: GetProjectById :one
-SELECT id, team_id, title, source_media, created FROM project WHERE id = $1 LIMIT 1
+const deleteTranslationModelsByTeamIdLanguages = `-- name: DeleteTranslationModelsByTeamIdLanguages :exec
+DELETE FROM translation_model WHERE team_id = $1 AND source_language = $2 AND target_language = $3
 `
 
-func (q *Queries) GetProjectById(ctx context.Context, id int64) (Project, error) {
-	row := q.db.QueryRowContext(ctx, getProjectById, id)
-	var i Project
+type DeleteTranslationModelsByTeamIdLanguagesParams struct {
+	TeamID         int64
+	SourceLanguage string
+	TargetLanguage string
+}
+
+func (q *Queries) DeleteTranslationModelsByTeamIdLanguages(ctx context.Context, arg DeleteTranslationModelsByTeamIdLanguagesParams) error {
+	_, err := q.db.ExecContext(ctx, deleteTranslationModelsByTeamIdLanguages, arg.TeamID, arg.SourceLanguage, arg.TargetLanguage)
+	return err
+}
+
+const deleteTranslationQaSegmentsByTransformationId = `-- name: DeleteTranslationQaSegmentsByTransformationId :exec
+DELETE FROM translation_qa_segment WHERE transformation_id = $1
+`
+
+func (q *Queries) DeleteTranslationQaSegmentsByTransformationId(ctx context.Context, transformationID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteTranslationQaSegmentsByTransformationId, transformationID)
+	return err
+}
+
+const getCreditReservationById = `-- name: GetCreditReservationById :one
+SELECT id, team_id, transformation_id, reserved_credits, reserved_overage_credits, captured_credits, status, overage_reported, created, updated FROM credit_reservation WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetCreditReservationById(ctx context.Context, id int64) (CreditReservation, error) {
+	row := q.db.QueryRowContext(ctx, getCreditReservationById, id)
+	var i CreditReservation
 	err := row.Scan(
 		&i.ID,
 		&i.TeamID,
-		&i.Title,
-		&i.SourceMedia,
+		&i.TransformationID,
+		&i.ReservedCredits,
+		&i.ReservedOverageCredits,
+		&i.CapturedCredits,
+		&i.Status,
+		&i.OverageReported,
 		&i.Created,
+		&i.Updated,
 	)
 	return i, err
 }
 
-const getProjectByProjectIdTeamId = `-- name: GetProjectByProjectIdTeamId :one
-SELECT id, team_id, title, source_media, created FROM project WHERE id = $1 AND team_id = $2 LIMIT 1
+const getCreditReservationByTransformationId = `-- name: GetCreditReservationByTransformationId :one
+SELECT id, team_id, transformation_id, reserved_credits, reserved_overage_credits, captured_credits, status, overage_reported, created, updated FROM credit_reservation WHERE transformation_id = $1 LIMIT 1
 `
 
-type GetProjectByProjectIdTeamIdParams struct {
-	ID     int64
-	TeamID int64
-}
-
-func (q *Queries) GetProjectByProjectIdTeamId(ctx context.Context, arg GetProjectByProjectIdTeamIdParams) (Project, error) {
-	row := q.db.QueryRowContext(ctx, getProjectByProjectIdTeamId, arg.ID, arg.TeamID)
-	var i Project
+func (q *Queries) GetCreditReservationByTransformationId(ctx context.Context, transformationID sql.NullInt64) (CreditReservation, error) {
+	row := q.db.QueryRowContext(ctx, getCreditReservationByTransformationId, transformationID)
+	var i CreditReservation
 	err := row.Scan(
 		&i.ID,
 		&i.TeamID,
-		&i.Title,
-		&i.SourceMedia,
+		&i.TransformationID,
+		&i.ReservedCredits,
+		&i.ReservedOverageCredits,
+		&i.CapturedCredits,
+		&i.Status,
+		&i.OverageReported,
 		&i.Created,
+		&i.Updated,
 	)
 	return i, err
 }
 
-const getProjectsByTeamId = `-- name: GetProjectsByTeamId :many
-SELECT id, team_id, title, source_media, created FROM project WHERE team_id = $1 ORDER BY created
+const getDubbedTransformationsWithoutMediaAssets = `-- name: GetDubbedTransformationsWithoutMediaAssets :many
+SELECT transformation.id, transformation.project_id, transformation.target_media FROM transformation
+WHERE transformation.is_source = FALSE AND transformation.target_media != ''
+  AND NOT EXISTS (SELECT 1 FROM media_asset WHERE media_asset.transformation_id = transformation.id AND media_asset.kind = 'DUB')
+  AND NOT EXISTS (SELECT 1 FROM media_asset WHERE media_asset.storage_key = transformation.target_media)
 `
 
-func (q *Queries) GetProjectsByTeamId(ctx context.Context, teamID int64) ([]Project, error) {
-	rows, err := q.db.QueryContext(ctx, getProjectsByTeamId, teamID)
+type GetDubbedTransformationsWithoutMediaAssetsRow struct {
+	ID          int64
+	ProjectID   int64
+	TargetMedia string
+}
+
+func (q *Queries) GetDubbedTransformationsWithoutMediaAssets(ctx context.Context) ([]GetDubbedTransformationsWithoutMediaAssetsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getDubbedTransformationsWithoutMediaAssets)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Project
+	var items []GetDubbedTransformationsWithoutMediaAssetsRow
 	for rows.Next() {
-		var i Project
+		var i GetDubbedTransformationsWithoutMediaAssetsRow
 		if err := rows.Scan(
 			&i.ID,
-			&i.TeamID,
-			&i.Title,
-			&i.SourceMedia,
-			&i.Created,
+			&i.ProjectID,
+			&i.TargetMedia,
 		); err != nil {
 			return nil, err
 		}
@@ -342,46 +879,752 @@ func (q *Queries) GetProjectsByTeamId(ctx context.Context, teamID int64) ([]Proj
 	return items, nil
 }
 
-const getSourceTransformationByProjectId = `-- name: GetSourceTransformationByProjectId :one
-SELECT id, project_id, target_language, target_media, transcript, is_source, status, progress, created FROM transformation WHERE project_id = $1 AND is_source = true LIMIT 1
+const getDubbingCacheEntriesUnusedSince = `-- name: GetDubbingCacheEntriesUnusedSince :many
+SELECT id, cache_key, kind, storage_key, hits, created, last_used FROM dubbing_cache_entry WHERE last_used < $1
 `
 
-func (q *Queries) GetSourceTransformationByProjectId(ctx context.Context, projectID int64) (Transformation, error) {
-	row := q.db.QueryRowContext(ctx, getSourceTransformationByProjectId, projectID)
-	var i Transformation
-	err := row.Scan(
-		&i.ID,
-		&i.ProjectID,
-		&i.TargetLanguage,
-		&i.TargetMedia,
-		&i.Transcript,
-		&i.IsSource,
-		&i.Status,
-		&i.Progress,
-		&i.Created,
-	)
-	return i, err
+func (q *Queries) GetDubbingCacheEntriesUnusedSince(ctx context.Context, lastUsed time.Time) ([]DubbingCacheEntry, error) {
+	rows, err := q.db.QueryContext(ctx, getDubbingCacheEntriesUnusedSince, lastUsed)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []DubbingCacheEntry
+	for rows.Next() {
+		var i DubbingCacheEntry
+		if err := rows.Scan(
+			&i.ID,
+			&i.CacheKey,
+			&i.Kind,
+			&i.StorageKey,
+			&i.Hits,
+			&i.Created,
+			&i.LastUsed,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const getSubscriptionById = `-- name: GetSubscriptionById :one
-SELECT id, team_id, stripe_subscription_id, remaining_credits, created FROM subscription_plan WHERE id = $1 LIMIT 1
+const getDubbingCacheEntryByCacheKey = `-- name: GetDubbingCacheEntryByCacheKey :one
+SELECT id, cache_key, kind, storage_key, hits, created, last_used FROM dubbing_cache_entry WHERE cache_key = $1 LIMIT 1
 `
 
-func (q *Queries) GetSubscriptionById(ctx context.Context, id int64) (SubscriptionPlan, error) {
-	row := q.db.QueryRowContext(ctx, getSubscriptionById, id)
-	var i SubscriptionPlan
+func (q *Queries) GetDubbingCacheEntryByCacheKey(ctx context.Context, cacheKey string) (DubbingCacheEntry, error) {
+	row := q.db.QueryRowContext(ctx, getDubbingCacheEntryByCacheKey, cacheKey)
+	var i DubbingCacheEntry
 	err := row.Scan(
 		&i.ID,
-		&i.TeamID,
-		&i.StripeSubscriptionID,
-		&i.RemainingCredits,
+		&i.CacheKey,
+		&i.Kind,
+		&i.StorageKey,
+		&i.Hits,
 		&i.Created,
+		&i.LastUsed,
 	)
 	return i, err
 }
 
-const getSubscriptionByStripeSubscriptionId = `-- name: GetSubscriptionByStripeSubscriptionId :one
-SELECT id, team_id, stripe_subscription_id, remaining_credits, created FROM subscription_plan WHERE stripe_subscription_id = $1 LIMIT 1
+const getDubbingCacheEntryStorageKeys = `-- name: GetDubbingCacheEntryStorageKeys :many
+SELECT storage_key FROM dubbing_cache_entry
+`
+
+func (q *Queries) GetDubbingCacheEntryStorageKeys(ctx context.Context) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, getDubbingCacheEntryStorageKeys)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var storage_key string
+		if err := rows.Scan(&storage_key); err != nil {
+			return nil, err
+		}
+		items = append(items, storage_key)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDubbingCacheStats = `-- name: GetDubbingCacheStats :many
+SELECT kind, COUNT(*) AS entries, SUM(hits)::BIGINT AS hits FROM dubbing_cache_entry GROUP BY kind ORDER BY kind
+`
+
+type GetDubbingCacheStatsRow struct {
+	Kind    DubbingCacheKind
+	Entries int64
+	Hits    int64
+}
+
+func (q *Queries) GetDubbingCacheStats(ctx context.Context) ([]GetDubbingCacheStatsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getDubbingCacheStats)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetDubbingCacheStatsRow
+	for rows.Next() {
+		var i GetDubbingCacheStatsRow
+		if err := rows.Scan(
+			&i.Kind,
+			&i.Entries,
+			&i.Hits,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getHeldCreditsByTeamId = `-- name: GetHeldCreditsByTeamId :one
+SELECT COALESCE(SUM(reserved_credits), 0)::BIGINT FROM credit_reservation WHERE team_id = $1 AND status = 'HELD'
+`
+
+func (q *Queries) GetHeldCreditsByTeamId(ctx context.Context, teamID int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getHeldCreditsByTeamId, teamID)
+	var column_1 int64
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const getMediaAssetByProjectIdKind = `-- name: GetMediaAssetByProjectIdKind :one
+SELECT id, project_id, transformation_id, kind, storage_key, size_bytes, checksum, duration_seconds, codec, created FROM media_asset WHERE project_id = $1 AND kind = $2 ORDER BY created DESC LIMIT 1
+`
+
+type GetMediaAssetByProjectIdKindParams struct {
+	ProjectID int64
+	Kind      MediaAssetKind
+}
+
+func (q *Queries) GetMediaAssetByProjectIdKind(ctx context.Context, arg GetMediaAssetByProjectIdKindParams) (MediaAsset, error) {
+	row := q.db.QueryRowContext(ctx, getMediaAssetByProjectIdKind, arg.ProjectID, arg.Kind)
+	var i MediaAsset
+	err := row.Scan(
+		&i.ID,
+		&i.ProjectID,
+		&i.TransformationID,
+		&i.Kind,
+		&i.StorageKey,
+		&i.SizeBytes,
+		&i.Checksum,
+		&i.DurationSeconds,
+		&i.Codec,
+		&i.Created,
+	)
+	return i, err
+}
+
+const getMediaAssetByTransformationIdKind = `-- name: GetMediaAssetByTransformationIdKind :one
+SELECT id, project_id, transformation_id, kind, storage_key, size_bytes, checksum, duration_seconds, codec, created FROM media_asset WHERE transformation_id = $1 AND kind = $2 ORDER BY created DESC LIMIT 1
+`
+
+type GetMediaAssetByTransformationIdKindParams struct {
+	TransformationID sql.NullInt64
+	Kind             MediaAssetKind
+}
+
+func (q *Queries) GetMediaAssetByTransformationIdKind(ctx context.Context, arg GetMediaAssetByTransformationIdKindParams) (MediaAsset, error) {
+	row := q.db.QueryRowContext(ctx, getMediaAssetByTransformationIdKind, arg.TransformationID, arg.Kind)
+	var i MediaAsset
+	err := row.Scan(
+		&i.ID,
+		&i.ProjectID,
+		&i.TransformationID,
+		&i.Kind,
+		&i.StorageKey,
+		&i.SizeBytes,
+		&i.Checksum,
+		&i.DurationSeconds,
+		&i.Codec,
+		&i.Created,
+	)
+	return i, err
+}
+
+const getMediaAssetStorageKeys = `-- name: GetMediaAssetStorageKeys :many
+SELECT storage_key FROM media_asset
+`
+
+func (q *Queries) GetMediaAssetStorageKeys(ctx context.Context) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, getMediaAssetStorageKeys)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var storage_key string
+		if err := rows.Scan(&storage_key); err != nil {
+			return nil, err
+		}
+		items = append(items, storage_key)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMediaAssetsByProjectId = `-- name: GetMediaAssetsByProjectId :many
+SELECT id, project_id, transformation_id, kind, storage_key, size_bytes, checksum, duration_seconds, codec, created FROM media_asset WHERE project_id = $1 ORDER BY created
+`
+
+func (q *Queries) GetMediaAssetsByProjectId(ctx context.Context, projectID int64) ([]MediaAsset, error) {
+	rows, err := q.db.QueryContext(ctx, getMediaAssetsByProjectId, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MediaAsset
+	for rows.Next() {
+		var i MediaAsset
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProjectID,
+			&i.TransformationID,
+			&i.Kind,
+			&i.StorageKey,
+			&i.SizeBytes,
+			&i.Checksum,
+			&i.DurationSeconds,
+			&i.Codec,
+			&i.Created,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMediaAssetsByTransformationId = `-- name: GetMediaAssetsByTransformationId :many
+SELECT id, project_id, transformation_id, kind, storage_key, size_bytes, checksum, duration_seconds, codec, created FROM media_asset WHERE transformation_id = $1 ORDER BY created
+`
+
+func (q *Queries) GetMediaAssetsByTransformationId(ctx context.Context, transformationID sql.NullInt64) ([]MediaAsset, error) {
+	rows, err := q.db.QueryContext(ctx, getMediaAssetsByTransformationId, transformationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MediaAsset
+	for rows.Next() {
+		var i MediaAsset
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProjectID,
+			&i.TransformationID,
+			&i.Kind,
+			&i.StorageKey,
+			&i.SizeBytes,
+			&i.Checksum,
+			&i.DurationSeconds,
+			&i.Codec,
+			&i.Created,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMediaAssetsWithTeamIdByKind = `-- name: GetMediaAssetsWithTeamIdByKind :many
+SELECT media_asset.id, media_asset.storage_key, media_asset.created, project.team_id FROM media_asset
+JOIN project ON project.id = media_asset.project_id
+WHERE media_asset.kind = $1
+`
+
+type GetMediaAssetsWithTeamIdByKindRow struct {
+	ID         int64
+	StorageKey string
+	Created    time.Time
+	TeamID     int64
+}
+
+func (q *Queries) GetMediaAssetsWithTeamIdByKind(ctx context.Context, kind MediaAssetKind) ([]GetMediaAssetsWithTeamIdByKindRow, error) {
+	rows, err := q.db.QueryContext(ctx, getMediaAssetsWithTeamIdByKind, kind)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetMediaAssetsWithTeamIdByKindRow
+	for rows.Next() {
+		var i GetMediaAssetsWithTeamIdByKindRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.StorageKey,
+			&i.Created,
+			&i.TeamID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getProjectById = `-- name: GetProjectById :one
+SELECT id, team_id, title, source_media, output_profile, loudness_target, source_language, transcription_prompt, transcription_vocabulary, transcription_model, created FROM project WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetProjectById(ctx context.Context, id int64) (Project, error) {
+	row := q.db.QueryRowContext(ctx, getProjectById, id)
+	var i Project
+	err := row.Scan(
+		&i.ID,
+		&i.TeamID,
+		&i.Title,
+		&i.SourceMedia,
+		&i.OutputProfile,
+		&i.LoudnessTarget,
+		&i.SourceLanguage,
+		&i.TranscriptionPrompt,
+		pq.Array(&i.TranscriptionVocabulary),
+		&i.TranscriptionModel,
+		&i.Created,
+	)
+	return i, err
+}
+
+const getProjectByProjectIdTeamId = `-- name: GetProjectByProjectIdTeamId :one
+SELECT id, team_id, title, source_media, output_profile, loudness_target, source_language, transcription_prompt, transcription_vocabulary, transcription_model, created FROM project WHERE id = $1 AND team_id = $2 LIMIT 1
+`
+
+type GetProjectByProjectIdTeamIdParams struct {
+	ID     int64
+	TeamID int64
+}
+
+func (q *Queries) GetProjectByProjectIdTeamId(ctx context.Context, arg GetProjectByProjectIdTeamIdParams) (Project, error) {
+	row := q.db.QueryRowContext(ctx, getProjectByProjectIdTeamId, arg.ID, arg.TeamID)
+	var i Project
+	err := row.Scan(
+		&i.ID,
+		&i.TeamID,
+		&i.Title,
+		&i.SourceMedia,
+		&i.OutputProfile,
+		&i.LoudnessTarget,
+		&i.SourceLanguage,
+		&i.TranscriptionPrompt,
+		pq.Array(&i.TranscriptionVocabulary),
+		&i.TranscriptionModel,
+		&i.Created,
+	)
+	return i, err
+}
+
+const getProjectSourceMedia = `-- name: GetProjectSourceMedia :many
+SELECT source_media FROM project
+`
+
+func (q *Queries) GetProjectSourceMedia(ctx context.Context) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, getProjectSourceMedia)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var source_media string
+		if err := rows.Scan(&source_media); err != nil {
+			return nil, err
+		}
+		items = append(items, source_media)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getProjectsByTeamId = `-- name: GetProjectsByTeamId :many
+SELECT id, team_id, title, source_media, output_profile, loudness_target, source_language, transcription_prompt, transcription_vocabulary, transcription_model, created FROM project WHERE team_id = $1 ORDER BY created
+`
+
+func (q *Queries) GetProjectsByTeamId(ctx context.Context, teamID int64) ([]Project, error) {
+	rows, err := q.db.QueryContext(ctx, getProjectsByTeamId, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Project
+	for rows.Next() {
+		var i Project
+		if err := rows.Scan(
+			&i.ID,
+			&i.TeamID,
+			&i.Title,
+			&i.SourceMedia,
+			&i.OutputProfile,
+			&i.LoudnessTarget,
+			&i.SourceLanguage,
+			&i.TranscriptionPrompt,
+			pq.Array(&i.TranscriptionVocabulary),
+			&i.TranscriptionModel,
+			&i.Created,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRetentionPoliciesByKind = `-- name: GetRetentionPoliciesByKind :many
+SELECT id, team_id, kind, retention_days, created FROM retention_policy WHERE kind = $1
+`
+
+func (q *Queries) GetRetentionPoliciesByKind(ctx context.Context, kind MediaAssetKind) ([]RetentionPolicy, error) {
+	rows, err := q.db.QueryContext(ctx, getRetentionPoliciesByKind, kind)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RetentionPolicy
+	for rows.Next() {
+		var i RetentionPolicy
+		if err := rows.Scan(
+			&i.ID,
+			&i.TeamID,
+			&i.Kind,
+			&i.RetentionDays,
+			&i.Created,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRetentionPoliciesByTeamId = `-- name: GetRetentionPoliciesByTeamId :many
+SELECT id, team_id, kind, retention_days, created FROM retention_policy WHERE team_id = $1 ORDER BY kind
+`
+
+func (q *Queries) GetRetentionPoliciesByTeamId(ctx context.Context, teamID int64) ([]RetentionPolicy, error) {
+	rows, err := q.db.QueryContext(ctx, getRetentionPoliciesByTeamId, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RetentionPolicy
+	for rows.Next() {
+		var i RetentionPolicy
+		if err := rows.Scan(
+			&i.ID,
+			&i.TeamID,
+			&i.Kind,
+			&i.RetentionDays,
+			&i.Created,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSourceMediaInfoByProjectId = `-- name: GetSourceMediaInfoByProjectId :one
+SELECT id, project_id, container, duration_seconds, video_codec, width, height, frame_rate, audio_codec, audio_channels, channel_layout, sample_rate, loudness_lufs, streams, created FROM source_media_info WHERE project_id = $1 LIMIT 1
+`
+
+func (q *Queries) GetSourceMediaInfoByProjectId(ctx context.Context, projectID int64) (SourceMediaInfo, error) {
+	row := q.db.QueryRowContext(ctx, getSourceMediaInfoByProjectId, projectID)
+	var i SourceMediaInfo
+	err := row.Scan(
+		&i.ID,
+		&i.ProjectID,
+		&i.Container,
+		&i.DurationSeconds,
+		&i.VideoCodec,
+		&i.Width,
+		&i.Height,
+		&i.FrameRate,
+		&i.AudioCodec,
+		&i.AudioChannels,
+		&i.ChannelLayout,
+		&i.SampleRate,
+		&i.LoudnessLufs,
+		&i.Streams,
+		&i.Created,
+	)
+	return i, err
+}
+
+const getSourceTransformationByProjectId = `-- name: GetSourceTransformationByProjectId :one
+SELECT id, project_id, target_language, target_media, transcript, is_source, status, progress, created FROM transformation WHERE project_id = $1 AND is_source = true LIMIT 1
+`
+
+func (q *Queries) GetSourceTransformationByProjectId(ctx context.Context, projectID int64) (Transformation, error) {
+	row := q.db.QueryRowContext(ctx, getSourceTransformationByProjectId, projectID)
+	var i Transformation
+	err := row.Scan(
+		&i.ID,
+		&i.ProjectID,
+		&i.TargetLanguage,
+		&i.TargetMedia,
+		&i.Transcript,
+		&i.IsSource,
+		&i.Status,
+		&i.Progress,
+		&i.Created,
+	)
+	return i, err
+}
+
+const getSourceTransformationsWithoutMediaAssets = `-- name: GetSourceTransformationsWithoutMediaAssets :many
+SELECT transformation.id, transformation.project_id, transformation.target_media, project.source_media FROM transformation
+JOIN project ON project.id = transformation.project_id
+WHERE transformation.is_source = TRUE AND project.source_media != '' AND (
+  NOT EXISTS (SELECT 1 FROM media_asset WHERE media_asset.project_id = project.id AND media_asset.kind = 'SOURCE')
+  OR NOT EXISTS (SELECT 1 FROM media_asset WHERE media_asset.transformation_id = transformation.id AND media_asset.kind = 'STEM')
+)
+`
+
+type GetSourceTransformationsWithoutMediaAssetsRow struct {
+	ID          int64
+	ProjectID   int64
+	TargetMedia string
+	SourceMedia string
+}
+
+func (q *Queries) GetSourceTransformationsWithoutMediaAssets(ctx context.Context) ([]GetSourceTransformationsWithoutMediaAssetsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getSourceTransformationsWithoutMediaAssets)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetSourceTransformationsWithoutMediaAssetsRow
+	for rows.Next() {
+		var i GetSourceTransformationsWithoutMediaAssetsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProjectID,
+			&i.TargetMedia,
+			&i.SourceMedia,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getStaleCreditReservations = `-- name: GetStaleCreditReservations :many
+SELECT id, team_id, transformation_id, reserved_credits, reserved_overage_credits, captured_credits, status, overage_reported, created, updated FROM credit_reservation WHERE status = 'HELD' AND created < $1 ORDER BY created
+`
+
+func (q *Queries) GetStaleCreditReservations(ctx context.Context, created time.Time) ([]CreditReservation, error) {
+	rows, err := q.db.QueryContext(ctx, getStaleCreditReservations, created)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CreditReservation
+	for rows.Next() {
+		var i CreditReservation
+		if err := rows.Scan(
+			&i.ID,
+			&i.TeamID,
+			&i.TransformationID,
+			&i.ReservedCredits,
+			&i.ReservedOverageCredits,
+			&i.CapturedCredits,
+			&i.Status,
+			&i.OverageReported,
+			&i.Created,
+			&i.Updated,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getStripeEventByStripeEventId = `-- name: GetStripeEventByStripeEventId :one
+SELECT id, stripe_event_id, event_type, payload, status, attempts, last_error, created, processed FROM stripe_event WHERE stripe_event_id = $1 LIMIT 1
+`
+
+func (q *Queries) GetStripeEventByStripeEventId(ctx context.Context, stripeEventID string) (StripeEvent, error) {
+	row := q.db.QueryRowContext(ctx, getStripeEventByStripeEventId, stripeEventID)
+	var i StripeEvent
+	err := row.Scan(
+		&i.ID,
+		&i.StripeEventID,
+		&i.EventType,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.LastError,
+		&i.Created,
+		&i.Processed,
+	)
+	return i, err
+}
+
+const getStripeEventByStripeEventIdForUpdate = `-- name: GetStripeEventByStripeEventIdForUpdate :one
+SELECT id, stripe_event_id, event_type, payload, status, attempts, last_error, created, processed FROM stripe_event WHERE stripe_event_id = $1 LIMIT 1 FOR UPDATE
+`
+
+func (q *Queries) GetStripeEventByStripeEventIdForUpdate(ctx context.Context, stripeEventID string) (StripeEvent, error) {
+	row := q.db.QueryRowContext(ctx, getStripeEventByStripeEventIdForUpdate, stripeEventID)
+	var i StripeEvent
+	err := row.Scan(
+		&i.ID,
+		&i.StripeEventID,
+		&i.EventType,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.LastError,
+		&i.Created,
+		&i.Processed,
+	)
+	return i, err
+}
+
+const getStripeEventsByStatus = `-- name: GetStripeEventsByStatus :many
+SELECT id, stripe_event_id, event_type, payload, status, attempts, last_error, created, processed FROM stripe_event WHERE status = $1 ORDER BY created
+`
+
+func (q *Queries) GetStripeEventsByStatus(ctx context.Context, status StripeEventStatus) ([]StripeEvent, error) {
+	rows, err := q.db.QueryContext(ctx, getStripeEventsByStatus, status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []StripeEvent
+	for rows.Next() {
+		var i StripeEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.StripeEventID,
+			&i.EventType,
+			&i.Payload,
+			&i.Status,
+			&i.Attempts,
+			&i.LastError,
+			&i.Created,
+			&i.Processed,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSubscriptionById = `-- name: GetSubscriptionById :one
+SELECT id, team_id, stripe_subscription_id, stripe_price_id, plan_tier, included_credits, subscription_status, past_due_since, remaining_credits, overage_enabled, overage_spend_cap_usd, overage_credits_used, created FROM subscription_plan WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetSubscriptionById(ctx context.Context, id int64) (SubscriptionPlan, error) {
+	row := q.db.QueryRowContext(ctx, getSubscriptionById, id)
+	var i SubscriptionPlan
+	err := row.Scan(
+		&i.ID,
+		&i.TeamID,
+		&i.StripeSubscriptionID,
+		&i.StripePriceID,
+		&i.PlanTier,
+		&i.IncludedCredits,
+		&i.SubscriptionStatus,
+		&i.PastDueSince,
+		&i.RemainingCredits,
+		&i.OverageEnabled,
+		&i.OverageSpendCapUsd,
+		&i.OverageCreditsUsed,
+		&i.Created,
+	)
+	return i, err
+}
+
+const getSubscriptionByStripeSubscriptionId = `-- name: GetSubscriptionByStripeSubscriptionId :one
+SELECT id, team_id, stripe_subscription_id, stripe_price_id, plan_tier, included_credits, subscription_status, past_due_since, remaining_credits, overage_enabled, overage_spend_cap_usd, overage_credits_used, created FROM subscription_plan WHERE stripe_subscription_id = $1 LIMIT 1
 `
 
 func (q *Queries) GetSubscriptionByStripeSubscriptionId(ctx context.Context, stripeSubscriptionID sql.NullString) (SubscriptionPlan, error) {
@@ -391,14 +1634,72 @@ func (q *Queries) GetSubscriptionByStripeSubscriptionId(ctx context.Context, str
 		&i.ID,
 		&i.TeamID,
 		&i.StripeSubscriptionID,
+		&i.StripePriceID,
+		&i.PlanTier,
+		&i.IncludedCredits,
+		&i.SubscriptionStatus,
+		&i.PastDueSince,
+		&i.RemainingCredits,
+		&i.OverageEnabled,
+		&i.OverageSpendCapUsd,
+		&i.OverageCreditsUsed,
+		&i.Created,
+	)
+	return i, err
+}
+
+const getSubscriptionByTeamId = `-- name: GetSubscriptionByTeamId :one
+SELECT id, team_id, stripe_subscription_id, stripe_price_id, plan_tier, included_credits, subscription_status, past_due_since, remaining_credits, overage_enabled, overage_spend_cap_usd, overage_credits_used, created FROM subscription_plan WHERE team_id = $1 LIMIT 1
+`
+
+func (q *Queries) GetSubscriptionByTeamId(ctx context.Context, teamID int64) (SubscriptionPlan, error) {
+	row := q.db.QueryRowContext(ctx, getSubscriptionByTeamId, teamID)
+	var i SubscriptionPlan
+	err := row.Scan(
+		&i.ID,
+		&i.TeamID,
+		&i.StripeSubscriptionID,
+		&i.StripePriceID,
+		&i.PlanTier,
+		&i.IncludedCredits,
+		&i.SubscriptionStatus,
+		&i.PastDueSince,
+		&i.RemainingCredits,
+		&i.OverageEnabled,
+		&i.OverageSpendCapUsd,
+		&i.OverageCreditsUsed,
+		&i.Created,
+	)
+	return i, err
+}
+
+const getSubscriptionByTeamIdForUpdate = `-- name: GetSubscriptionByTeamIdForUpdate :one
+SELECT id, team_id, stripe_subscription_id, stripe_price_id, plan_tier, included_credits, subscription_status, past_due_since, remaining_credits, overage_enabled, overage_spend_cap_usd, overage_credits_used, created FROM subscription_plan WHERE team_id = $1 LIMIT 1 FOR UPDATE
+`
+
+func (q *Queries) GetSubscriptionByTeamIdForUpdate(ctx context.Context, teamID int64) (SubscriptionPlan, error) {
+	row := q.db.QueryRowContext(ctx, getSubscriptionByTeamIdForUpdate, teamID)
+	var i SubscriptionPlan
+	err := row.Scan(
+		&i.ID,
+		&i.TeamID,
+		&i.StripeSubscriptionID,
+		&i.StripePriceID,
+		&i.PlanTier,
+		&i.IncludedCredits,
+		&i.SubscriptionStatus,
+		&i.PastDueSince,
 		&i.RemainingCredits,
+		&i.OverageEnabled,
+		&i.OverageSpendCapUsd,
+		&i.OverageCreditsUsed,
 		&i.Created,
 	)
 	return i, err
 }
 
 const getSubscriptionByTeamIdSubscriptionId = `-- name: GetSubscriptionByTeamIdSubscriptionId :one
-SELECT id, team_id, stripe_subscription_id, remaining_credits, created FROM subscription_plan WHERE team_id = $1 AND id = $2 LIMIT 1
+SELECT id, team_id, stripe_subscription_id, stripe_price_id, plan_tier, included_credits, subscription_status, past_due_since, remaining_credits, overage_enabled, overage_spend_cap_usd, overage_credits_used, created FROM subscription_plan WHERE team_id = $1 AND id = $2 LIMIT 1
 `
 
 type GetSubscriptionByTeamIdSubscriptionIdParams struct {
@@ -413,14 +1714,22 @@ func (q *Queries) GetSubscriptionByTeamIdSubscriptionId(ctx context.Context, arg
 		&i.ID,
 		&i.TeamID,
 		&i.StripeSubscriptionID,
+		&i.StripePriceID,
+		&i.PlanTier,
+		&i.IncludedCredits,
+		&i.SubscriptionStatus,
+		&i.PastDueSince,
 		&i.RemainingCredits,
+		&i.OverageEnabled,
+		&i.OverageSpendCapUsd,
+		&i.OverageCreditsUsed,
 		&i.Created,
 	)
 	return i, err
 }
 
 const getSubscriptionsByTeamId = `-- name: GetSubscriptionsByTeamId :many
-SELECT id, team_id, stripe_subscription_id, remaining_credits, created FROM subscription_plan WHERE team_id = $1 ORDER BY created
+SELECT id, team_id, stripe_subscription_id, stripe_price_id, plan_tier, included_credits, subscription_status, past_due_since, remaining_credits, overage_enabled, overage_spend_cap_usd, overage_credits_used, created FROM subscription_plan WHERE team_id = $1 ORDER BY created
 `
 
 func (q *Queries) GetSubscriptionsByTeamId(ctx context.Context, teamID int64) ([]SubscriptionPlan, error) {
@@ -436,7 +1745,15 @@ func (q *Queries) GetSubscriptionsByTeamId(ctx context.Context, teamID int64) ([
 			&i.ID,
 			&i.TeamID,
 			&i.StripeSubscriptionID,
+			&i.StripePriceID,
+			&i.PlanTier,
+			&i.IncludedCredits,
+			&i.SubscriptionStatus,
+			&i.PastDueSince,
 			&i.RemainingCredits,
+			&i.OverageEnabled,
+			&i.OverageSpendCapUsd,
+			&i.OverageCreditsUsed,
 			&i.Created,
 		); err != nil {
 			return nil, err
@@ -453,7 +1770,7 @@ func (q *Queries) GetSubscriptionsByTeamId(ctx context.Context, teamID int64) ([
 }
 
 const getTeamById = `-- name: GetTeamById :one
-SELECT id, slug, name, stripe_customer_id, team_type, created FROM team WHERE id = $1 LIMIT 1
+SELECT id, slug, name, stripe_customer_id, team_type, dubbing_cache_enabled, default_output_profile, translation_qa_mode, created FROM team WHERE id = $1 LIMIT 1
 `
 
 func (q *Queries) GetTeamById(ctx context.Context, id int64) (Team, error) {
@@ -465,13 +1782,16 @@ func (q *Queries) GetTeamById(ctx context.Context, id int64) (Team, error) {
 		&i.Name,
 		&i.StripeCustomerID,
 		&i.TeamType,
+		&i.DubbingCacheEnabled,
+		&i.DefaultOutputProfile,
+		&i.TranslationQaMode,
 		&i.Created,
 	)
 	return i, err
 }
 
 const getTeamBySlug = `-- name: GetTeamBySlug :one
-SELECT id, slug, name, stripe_customer_id, team_type, created FROM team WHERE slug = $1 LIMIT 1
+SELECT id, slug, name, stripe_customer_id, team_type, dubbing_cache_enabled, default_output_profile, translation_qa_mode, created FROM team WHERE slug = $1 LIMIT 1
 `
 
 func (q *Queries) GetTeamBySlug(ctx context.Context, slug string) (Team, error) {
@@ -483,13 +1803,16 @@ func (q *Queries) GetTeamBySlug(ctx context.Context, slug string) (Team, error)
 		&i.Name,
 		&i.StripeCustomerID,
 		&i.TeamType,
+		&i.DubbingCacheEnabled,
+		&i.DefaultOutputProfile,
+		&i.TranslationQaMode,
 		&i.Created,
 	)
 	return i, err
 }
 
 const getTeamByStripeCustomerId = `-- name: GetTeamByStripeCustomerId :one
-SELECT id, slug, name, stripe_customer_id, team_type, created FROM team WHERE stripe_customer_id = $1
+SELECT id, slug, name, stripe_customer_id, team_type, dubbing_cache_enabled, default_output_profile, translation_qa_mode, created FROM team WHERE stripe_customer_id = $1
 `
 
 func (q *Queries) GetTeamByStripeCustomerId(ctx context.Context, stripeCustomerID sql.NullString) (Team, error) {
@@ -501,6 +1824,9 @@ func (q *Queries) GetTeamByStripeCustomerId(ctx context.Context, stripeCustomerI
 		&i.Name,
 		&i.StripeCustomerID,
 		&i.TeamType,
+		&i.DubbingCacheEnabled,
+		&i.DefaultOutputProfile,
+		&i.TranslationQaMode,
 		&i.Created,
 	)
 	return i, err
@@ -750,29 +2076,240 @@ func (q *Queries) GetTransformationByTransformationIdProjectId(ctx context.Conte
 	return i, err
 }
 
+const getTransformationTargetMedia = `-- name: GetTransformationTargetMedia :many
+SELECT target_media FROM transformation
+`
+
+func (q *Queries) GetTransformationTargetMedia(ctx context.Context) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, getTransformationTargetMedia)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var target_media string
+		if err := rows.Scan(&target_media); err != nil {
+			return nil, err
+		}
+		items = append(items, target_media)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getTransformationsByProjectId = `-- name: GetTransformationsByProjectId :many
 SELECT id, project_id, target_language, target_media, transcript, is_source, status, progress, created FROM transformation WHERE project_id = $1 ORDER BY created
 `
 
-func (q *Queries) GetTransformationsByProjectId(ctx context.Context, projectID int64) ([]Transformation, error) {
-	rows, err := q.db.QueryContext(ctx, getTransformationsByProjectId, projectID)
+func (q *Queries) GetTransformationsByProjectId(ctx context.Context, projectID int64) ([]Transformation, error) {
+	rows, err := q.db.QueryContext(ctx, getTransformationsByProjectId, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Transformation
+	for rows.Next() {
+		var i Transformation
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProjectID,
+			&i.TargetLanguage,
+			&i.TargetMedia,
+			&i.Transcript,
+			&i.IsSource,
+			&i.Status,
+			&i.Progress,
+			&i.Created,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTranslationModelsByTeamId = `-- name: GetTranslationModelsByTeamId :many
+SELECT id, team_id, source_language, target_language, position, provider, model, temperature, max_tokens, json_response, created FROM translation_model WHERE team_id = $1 ORDER BY source_language, target_language, position
+`
+
+func (q *Queries) GetTranslationModelsByTeamId(ctx context.Context, teamID int64) ([]TranslationModel, error) {
+	rows, err := q.db.QueryContext(ctx, getTranslationModelsByTeamId, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TranslationModel
+	for rows.Next() {
+		var i TranslationModel
+		if err := rows.Scan(
+			&i.ID,
+			&i.TeamID,
+			&i.SourceLanguage,
+			&i.TargetLanguage,
+			&i.Position,
+			&i.Provider,
+			&i.Model,
+			&i.Temperature,
+			&i.MaxTokens,
+			&i.JsonResponse,
+			&i.Created,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTranslationQaSegmentsByTransformationId = `-- name: GetTranslationQaSegmentsByTransformationId :many
+SELECT id, transformation_id, segment_id, source_text, translated_text, back_translation, similarity, length_fit, score, flagged, retranslated, created FROM translation_qa_segment WHERE transformation_id = $1 ORDER BY segment_id
+`
+
+func (q *Queries) GetTranslationQaSegmentsByTransformationId(ctx context.Context, transformationID int64) ([]TranslationQaSegment, error) {
+	rows, err := q.db.QueryContext(ctx, getTranslationQaSegmentsByTransformationId, transformationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TranslationQaSegment
+	for rows.Next() {
+		var i TranslationQaSegment
+		if err := rows.Scan(
+			&i.ID,
+			&i.TransformationID,
+			&i.SegmentID,
+			&i.SourceText,
+			&i.TranslatedText,
+			&i.BackTranslation,
+			&i.Similarity,
+			&i.LengthFit,
+			&i.Score,
+			&i.Flagged,
+			&i.Retranslated,
+			&i.Created,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUnreportedOverageReservations = `-- name: GetUnreportedOverageReservations :many
+SELECT id, team_id, transformation_id, reserved_credits, reserved_overage_credits, captured_credits, status, overage_reported, created, updated FROM credit_reservation
+WHERE status = 'CAPTURED' AND overage_reported = FALSE AND captured_credits > reserved_credits - reserved_overage_credits
+ORDER BY updated
+`
+
+func (q *Queries) GetUnreportedOverageReservations(ctx context.Context) ([]CreditReservation, error) {
+	rows, err := q.db.QueryContext(ctx, getUnreportedOverageReservations)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CreditReservation
+	for rows.Next() {
+		var i CreditReservation
+		if err := rows.Scan(
+			&i.ID,
+			&i.TeamID,
+			&i.TransformationID,
+			&i.ReservedCredits,
+			&i.ReservedOverageCredits,
+			&i.CapturedCredits,
+			&i.Status,
+			&i.OverageReported,
+			&i.Created,
+			&i.Updated,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUploadSessionByIdTeamId = `-- name: GetUploadSessionByIdTeamId :one
+SELECT id, team_id, upload_key, storage_upload_id, file_name, file_size, part_size, status, created, completed FROM upload_session WHERE id = $1 AND team_id = $2 LIMIT 1
+`
+
+type GetUploadSessionByIdTeamIdParams struct {
+	ID     int64
+	TeamID int64
+}
+
+func (q *Queries) GetUploadSessionByIdTeamId(ctx context.Context, arg GetUploadSessionByIdTeamIdParams) (UploadSession, error) {
+	row := q.db.QueryRowContext(ctx, getUploadSessionByIdTeamId, arg.ID, arg.TeamID)
+	var i UploadSession
+	err := row.Scan(
+		&i.ID,
+		&i.TeamID,
+		&i.UploadKey,
+		&i.StorageUploadID,
+		&i.FileName,
+		&i.FileSize,
+		&i.PartSize,
+		&i.Status,
+		&i.Created,
+		&i.Completed,
+	)
+	return i, err
+}
+
+const getUploadSessionsByStatus = `-- name: GetUploadSessionsByStatus :many
+SELECT id, team_id, upload_key, storage_upload_id, file_name, file_size, part_size, status, created, completed FROM upload_session WHERE status = $1 ORDER BY created
+`
+
+func (q *Queries) GetUploadSessionsByStatus(ctx context.Context, status UploadStatus) ([]UploadSession, error) {
+	rows, err := q.db.QueryContext(ctx, getUploadSessionsByStatus, status)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Transformation
+	var items []UploadSession
 	for rows.Next() {
-		var i Transformation
+		var i UploadSession
 		if err := rows.Scan(
 			&i.ID,
-			&i.ProjectID,
-			&i.TargetLanguage,
-			&i.TargetMedia,
-			&i.Transcript,
-			&i.IsSource,
+			&i.TeamID,
+			&i.UploadKey,
+			&i.StorageUploadID,
+			&i.FileName,
+			&i.FileSize,
+			&i.PartSize,
 			&i.Status,
-			&i.Progress,
 			&i.Created,
+			&i.Completed,
 		); err != nil {
 			return nil, err
 		}
@@ -819,8 +2356,136 @@ func (q *Queries) GetUserById(ctx context.Context, id int64) (Userinfo, error) {
 	return i, err
 }
 
+const recordDubbingCacheHitById = `-- name: RecordDubbingCacheHitById :exec
+UPDATE dubbing_cache_entry SET hits = hits + 1, last_used = clock_timestamp() WHERE id = $1
+`
+
+func (q *Queries) RecordDubbingCacheHitById(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, recordDubbingCacheHitById, id)
+	return err
+}
+
+const releaseCreditReservationById = `-- name: ReleaseCreditReservationById :one
+UPDATE credit_reservation SET status = 'RELEASED', captured_credits = 0, updated = clock_timestamp()
+WHERE id = $1 AND status = 'HELD' RETURNING id, team_id, transformation_id, reserved_credits, reserved_overage_credits, captured_credits, status, overage_reported, created, updated
+`
+
+func (q *Queries) ReleaseCreditReservationById(ctx context.Context, id int64) (CreditReservation, error) {
+	row := q.db.QueryRowContext(ctx, releaseCreditReservationById, id)
+	var i CreditReservation
+	err := row.Scan(
+		&i.ID,
+		&i.TeamID,
+		&i.TransformationID,
+		&i.ReservedCredits,
+		&i.ReservedOverageCredits,
+		&i.CapturedCredits,
+		&i.Status,
+		&i.OverageReported,
+		&i.Created,
+		&i.Updated,
+	)
+	return i, err
+}
+
+const resetOverageCreditsUsedByTeamId = `-- name: ResetOverageCreditsUsedByTeamId :one
+UPDATE subscription_plan SET overage_credits_used = 0 WHERE team_id = $1 RETURNING id, team_id, stripe_subscription_id, stripe_price_id, plan_tier, included_credits, subscription_status, past_due_since, remaining_credits, overage_enabled, overage_spend_cap_usd, overage_credits_used, created
+`
+
+func (q *Queries) ResetOverageCreditsUsedByTeamId(ctx context.Context, teamID int64) (SubscriptionPlan, error) {
+	row := q.db.QueryRowContext(ctx, resetOverageCreditsUsedByTeamId, teamID)
+	var i SubscriptionPlan
+	err := row.Scan(
+		&i.ID,
+		&i.TeamID,
+		&i.StripeSubscriptionID,
+		&i.StripePriceID,
+		&i.PlanTier,
+		&i.IncludedCredits,
+		&i.SubscriptionStatus,
+		&i.PastDueSince,
+		&i.RemainingCredits,
+		&i.OverageEnabled,
+		&i.OverageSpendCapUsd,
+		&i.OverageCreditsUsed,
+		&i.Created,
+	)
+	return i, err
+}
+
+const setCreditBalancesById = `-- name: SetCreditBalancesById :one
+UPDATE subscription_plan SET remaining_credits = $2, overage_credits_used = $3 WHERE id = $1 RETURNING id, team_id, stripe_subscription_id, stripe_price_id, plan_tier, included_credits, subscription_status, past_due_since, remaining_credits, overage_enabled, overage_spend_cap_usd, overage_credits_used, created
+`
+
+type SetCreditBalancesByIdParams struct {
+	ID                 int64
+	RemainingCredits   int64
+	OverageCreditsUsed int64
+}
+
+func (q *Queries) SetCreditBalancesById(ctx context.Context, arg SetCreditBalancesByIdParams) (SubscriptionPlan, error) {
+	row := q.db.QueryRowContext(ctx, setCreditBalancesById, arg.ID, arg.RemainingCredits, arg.OverageCreditsUsed)
+	var i SubscriptionPlan
+	err := row.Scan(
+		&i.ID,
+		&i.TeamID,
+		&i.StripeSubscriptionID,
+		&i.StripePriceID,
+		&i.PlanTier,
+		&i.IncludedCredits,
+		&i.SubscriptionStatus,
+		&i.PastDueSince,
+		&i.RemainingCredits,
+		&i.OverageEnabled,
+		&i.OverageSpendCapUsd,
+		&i.OverageCreditsUsed,
+		&i.Created,
+	)
+	return i, err
+}
+
+const setCreditReservationOverageReportedById = `-- name: SetCreditReservationOverageReportedById :exec
+UPDATE credit_reservation SET overage_reported = TRUE, updated = clock_timestamp() WHERE id = $1
+`
+
+func (q *Queries) SetCreditReservationOverageReportedById(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, setCreditReservationOverageReportedById, id)
+	return err
+}
+
+const setOverageSettingsByTeamId = `-- name: SetOverageSettingsByTeamId :one
+UPDATE subscription_plan SET overage_enabled = $2, overage_spend_cap_usd = $3 WHERE team_id = $1 RETURNING id, team_id, stripe_subscription_id, stripe_price_id, plan_tier, included_credits, subscription_status, past_due_since, remaining_credits, overage_enabled, overage_spend_cap_usd, overage_credits_used, created
+`
+
+type SetOverageSettingsByTeamIdParams struct {
+	TeamID             int64
+	OverageEnabled     bool
+	OverageSpendCapUsd int64
+}
+
+func (q *Queries) SetOverageSettingsByTeamId(ctx context.Context, arg SetOverageSettingsByTeamIdParams) (SubscriptionPlan, error) {
+	row := q.db.QueryRowContext(ctx, setOverageSettingsByTeamId, arg.TeamID, arg.OverageEnabled, arg.OverageSpendCapUsd)
+	var i SubscriptionPlan
+	err := row.Scan(
+		&i.ID,
+		&i.TeamID,
+		&i.StripeSubscriptionID,
+		&i.StripePriceID,
+		&i.PlanTier,
+		&i.IncludedCredits,
+		&i.SubscriptionStatus,
+		&i.PastDueSince,
+		&i.RemainingCredits,
+		&i.OverageEnabled,
+		&i.OverageSpendCapUsd,
+		&i.OverageCreditsUsed,
+		&i.Created,
+	)
+	return i, err
+}
+
 const setRemainingCreditsById = `-- name: SetRemainingCreditsById :one
-UPDATE subscription_plan SET remaining_credits = $2 WHERE id = $1 RETURNING id, team_id, stripe_subscription_id, remaining_credits, created
+UPDATE subscription_plan SET remaining_credits = $2 WHERE id = $1 RETURNING id, team_id, stripe_subscription_id, stripe_price_id, plan_tier, included_credits, subscription_status, past_due_since, remaining_credits, overage_enabled, overage_spend_cap_usd, overage_credits_used, created
 `
 
 type SetRemainingCreditsByIdParams struct {
@@ -835,14 +2500,203 @@ func (q *Queries) SetRemainingCreditsById(ctx context.Context, arg SetRemainingC
 		&i.ID,
 		&i.TeamID,
 		&i.StripeSubscriptionID,
+		&i.StripePriceID,
+		&i.PlanTier,
+		&i.IncludedCredits,
+		&i.SubscriptionStatus,
+		&i.PastDueSince,
+		&i.RemainingCredits,
+		&i.OverageEnabled,
+		&i.OverageSpendCapUsd,
+		&i.OverageCreditsUsed,
+		&i.Created,
+	)
+	return i, err
+}
+
+const setRetentionPolicy = `-- name: SetRetentionPolicy :one
+INSERT INTO retention_policy
+(team_id, kind, retention_days, created)
+VALUES ($1, $2, $3, clock_timestamp())
+ON CONFLICT (team_id, kind) DO UPDATE SET retention_days = EXCLUDED.retention_days
+RETURNING id, team_id, kind, retention_days, created
+`
+
+type SetRetentionPolicyParams struct {
+	TeamID        int64
+	Kind          MediaAssetKind
+	RetentionDays int64
+}
+
+func (q *Queries) SetRetentionPolicy(ctx context.Context, arg SetRetentionPolicyParams) (RetentionPolicy, error) {
+	row := q.db.QueryRowContext(ctx, setRetentionPolicy, arg.TeamID, arg.Kind, arg.RetentionDays)
+	var i RetentionPolicy
+	err := row.Scan(
+		&i.ID,
+		&i.TeamID,
+		&i.Kind,
+		&i.RetentionDays,
+		&i.Created,
+	)
+	return i, err
+}
+
+const setSourceMediaInfoLoudnessByProjectId = `-- name: SetSourceMediaInfoLoudnessByProjectId :one
+UPDATE source_media_info SET loudness_lufs = $2 WHERE project_id = $1 RETURNING id, project_id, container, duration_seconds, video_codec, width, height, frame_rate, audio_codec, audio_channels, channel_layout, sample_rate, loudness_lufs, streams, created
+`
+
+type SetSourceMediaInfoLoudnessByProjectIdParams struct {
+	ProjectID    int64
+	LoudnessLufs sql.NullFloat64
+}
+
+func (q *Queries) SetSourceMediaInfoLoudnessByProjectId(ctx context.Context, arg SetSourceMediaInfoLoudnessByProjectIdParams) (SourceMediaInfo, error) {
+	row := q.db.QueryRowContext(ctx, setSourceMediaInfoLoudnessByProjectId, arg.ProjectID, arg.LoudnessLufs)
+	var i SourceMediaInfo
+	err := row.Scan(
+		&i.ID,
+		&i.ProjectID,
+		&i.Container,
+		&i.DurationSeconds,
+		&i.VideoCodec,
+		&i.Width,
+		&i.Height,
+		&i.FrameRate,
+		&i.AudioCodec,
+		&i.AudioChannels,
+		&i.ChannelLayout,
+		&i.SampleRate,
+		&i.LoudnessLufs,
+		&i.Streams,
+		&i.Created,
+	)
+	return i, err
+}
+
+const setStripeEventFailedById = `-- name: SetStripeEventFailedById :one
+UPDATE stripe_event SET status = 'FAILED', attempts = attempts + 1, last_error = $2
+WHERE id = $1 RETURNING id, stripe_event_id, event_type, payload, status, attempts, last_error, created, processed
+`
+
+type SetStripeEventFailedByIdParams struct {
+	ID        int64
+	LastError sql.NullString
+}
+
+func (q *Queries) SetStripeEventFailedById(ctx context.Context, arg SetStripeEventFailedByIdParams) (StripeEvent, error) {
+	row := q.db.QueryRowContext(ctx, setStripeEventFailedById, arg.ID, arg.LastError)
+	var i StripeEvent
+	err := row.Scan(
+		&i.ID,
+		&i.StripeEventID,
+		&i.EventType,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.LastError,
+		&i.Created,
+		&i.Processed,
+	)
+	return i, err
+}
+
+const setStripeEventProcessedById = `-- name: SetStripeEventProcessedById :one
+UPDATE stripe_event SET status = $2, attempts = attempts + 1, last_error = NULL, processed = clock_timestamp()
+WHERE id = $1 RETURNING id, stripe_event_id, event_type, payload, status, attempts, last_error, created, processed
+`
+
+type SetStripeEventProcessedByIdParams struct {
+	ID     int64
+	Status StripeEventStatus
+}
+
+func (q *Queries) SetStripeEventProcessedById(ctx context.Context, arg SetStripeEventProcessedByIdParams) (StripeEvent, error) {
+	row := q.db.QueryRowContext(ctx, setStripeEventProcessedById, arg.ID, arg.Status)
+	var i StripeEvent
+	err := row.Scan(
+		&i.ID,
+		&i.StripeEventID,
+		&i.EventType,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.LastError,
+		&i.Created,
+		&i.Processed,
+	)
+	return i, err
+}
+
+const setSubscriptionPlanTierByTeamId = `-- name: SetSubscriptionPlanTierByTeamId :one
+UPDATE subscription_plan SET stripe_price_id = $2, plan_tier = $3, included_credits = $4 WHERE team_id = $1 RETURNING id, team_id, stripe_subscription_id, stripe_price_id, plan_tier, included_credits, subscription_status, past_due_since, remaining_credits, overage_enabled, overage_spend_cap_usd, overage_credits_used, created
+`
+
+type SetSubscriptionPlanTierByTeamIdParams struct {
+	TeamID          int64
+	StripePriceID   sql.NullString
+	PlanTier        string
+	IncludedCredits int64
+}
+
+func (q *Queries) SetSubscriptionPlanTierByTeamId(ctx context.Context, arg SetSubscriptionPlanTierByTeamIdParams) (SubscriptionPlan, error) {
+	row := q.db.QueryRowContext(ctx, setSubscriptionPlanTierByTeamId,
+		arg.TeamID,
+		arg.StripePriceID,
+		arg.PlanTier,
+		arg.IncludedCredits,
+	)
+	var i SubscriptionPlan
+	err := row.Scan(
+		&i.ID,
+		&i.TeamID,
+		&i.StripeSubscriptionID,
+		&i.StripePriceID,
+		&i.PlanTier,
+		&i.IncludedCredits,
+		&i.SubscriptionStatus,
+		&i.PastDueSince,
+		&i.RemainingCredits,
+		&i.OverageEnabled,
+		&i.OverageSpendCapUsd,
+		&i.OverageCreditsUsed,
+		&i.Created,
+	)
+	return i, err
+}
+
+const setSubscriptionStatusByTeamId = `-- name: SetSubscriptionStatusByTeamId :one
+UPDATE subscription_plan SET subscription_status = $2, past_due_since = $3 WHERE team_id = $1 RETURNING id, team_id, stripe_subscription_id, stripe_price_id, plan_tier, included_credits, subscription_status, past_due_since, remaining_credits, overage_enabled, overage_spend_cap_usd, overage_credits_used, created
+`
+
+type SetSubscriptionStatusByTeamIdParams struct {
+	TeamID             int64
+	SubscriptionStatus string
+	PastDueSince       sql.NullTime
+}
+
+func (q *Queries) SetSubscriptionStatusByTeamId(ctx context.Context, arg SetSubscriptionStatusByTeamIdParams) (SubscriptionPlan, error) {
+	row := q.db.QueryRowContext(ctx, setSubscriptionStatusByTeamId, arg.TeamID, arg.SubscriptionStatus, arg.PastDueSince)
+	var i SubscriptionPlan
+	err := row.Scan(
+		&i.ID,
+		&i.TeamID,
+		&i.StripeSubscriptionID,
+		&i.StripePriceID,
+		&i.PlanTier,
+		&i.IncludedCredits,
+		&i.SubscriptionStatus,
+		&i.PastDueSince,
 		&i.RemainingCredits,
+		&i.OverageEnabled,
+		&i.OverageSpendCapUsd,
+		&i.OverageCreditsUsed,
 		&i.Created,
 	)
 	return i, err
 }
 
 const setSubscriptionStripeIdByTeamId = `-- name: SetSubscriptionStripeIdByTeamId :one
-UPDATE subscription_plan SET stripe_subscription_id = $2 WHERE team_id = $1 RETURNING id, team_id, stripe_subscription_id, remaining_credits, created
+UPDATE subscription_plan SET stripe_subscription_id = $2 WHERE team_id = $1 RETURNING id, team_id, stripe_subscription_id, stripe_price_id, plan_tier, included_credits, subscription_status, past_due_since, remaining_credits, overage_enabled, overage_spend_cap_usd, overage_credits_used, created
 `
 
 type SetSubscriptionStripeIdByTeamIdParams struct {
@@ -857,14 +2711,121 @@ func (q *Queries) SetSubscriptionStripeIdByTeamId(ctx context.Context, arg SetSu
 		&i.ID,
 		&i.TeamID,
 		&i.StripeSubscriptionID,
+		&i.StripePriceID,
+		&i.PlanTier,
+		&i.IncludedCredits,
+		&i.SubscriptionStatus,
+		&i.PastDueSince,
 		&i.RemainingCredits,
+		&i.OverageEnabled,
+		&i.OverageSpendCapUsd,
+		&i.OverageCreditsUsed,
+		&i.Created,
+	)
+	return i, err
+}
+
+const setTeamDefaultOutputProfileById = `-- name: SetTeamDefaultOutputProfileById :one
+UPDATE team SET default_output_profile = $2 WHERE id = $1 RETURNING id, slug, name, stripe_customer_id, team_type, dubbing_cache_enabled, default_output_profile, translation_qa_mode, created
+`
+
+type SetTeamDefaultOutputProfileByIdParams struct {
+	ID                   int64
+	DefaultOutputProfile OutputProfile
+}
+
+func (q *Queries) SetTeamDefaultOutputProfileById(ctx context.Context, arg SetTeamDefaultOutputProfileByIdParams) (Team, error) {
+	row := q.db.QueryRowContext(ctx, setTeamDefaultOutputProfileById, arg.ID, arg.DefaultOutputProfile)
+	var i Team
+	err := row.Scan(
+		&i.ID,
+		&i.Slug,
+		&i.Name,
+		&i.StripeCustomerID,
+		&i.TeamType,
+		&i.DubbingCacheEnabled,
+		&i.DefaultOutputProfile,
+		&i.TranslationQaMode,
+		&i.Created,
+	)
+	return i, err
+}
+
+const setTeamDubbingCacheEnabledById = `-- name: SetTeamDubbingCacheEnabledById :one
+UPDATE team SET dubbing_cache_enabled = $2 WHERE id = $1 RETURNING id, slug, name, stripe_customer_id, team_type, dubbing_cache_enabled, default_output_profile, translation_qa_mode, created
+`
+
+type SetTeamDubbingCacheEnabledByIdParams struct {
+	ID                  int64
+	DubbingCacheEnabled bool
+}
+
+func (q *Queries) SetTeamDubbingCacheEnabledById(ctx context.Context, arg SetTeamDubbingCacheEnabledByIdParams) (Team, error) {
+	row := q.db.QueryRowContext(ctx, setTeamDubbingCacheEnabledById, arg.ID, arg.DubbingCacheEnabled)
+	var i Team
+	err := row.Scan(
+		&i.ID,
+		&i.Slug,
+		&i.Name,
+		&i.StripeCustomerID,
+		&i.TeamType,
+		&i.DubbingCacheEnabled,
+		&i.DefaultOutputProfile,
+		&i.TranslationQaMode,
+		&i.Created,
+	)
+	return i, err
+}
+
+const setTeamTranslationQaModeById = `-- name: SetTeamTranslationQaModeById :one
+UPDATE team SET translation_qa_mode = $2 WHERE id = $1 RETURNING id, slug, name, stripe_customer_id, team_type, dubbing_cache_enabled, default_output_profile, translation_qa_mode, created
+`
+
+type SetTeamTranslationQaModeByIdParams struct {
+	ID                int64
+	TranslationQaMode TranslationQaMode
+}
+
+func (q *Queries) SetTeamTranslationQaModeById(ctx context.Context, arg SetTeamTranslationQaModeByIdParams) (Team, error) {
+	row := q.db.QueryRowContext(ctx, setTeamTranslationQaModeById, arg.ID, arg.TranslationQaMode)
+	var i Team
+	err := row.Scan(
+		&i.ID,
+		&i.Slug,
+		&i.Name,
+		&i.StripeCustomerID,
+		&i.TeamType,
+		&i.DubbingCacheEnabled,
+		&i.DefaultOutputProfile,
+		&i.TranslationQaMode,
+		&i.Created,
+	)
+	return i, err
+}
+
+const startTransformationProcessingById = `-- name: StartTransformationProcessingById :one
+UPDATE transformation SET status = 'processing' WHERE id = $1 AND status <> 'processing' RETURNING id, project_id, target_language, target_media, transcript, is_source, status, progress, created
+`
+
+func (q *Queries) StartTransformationProcessingById(ctx context.Context, id int64) (Transformation, error) {
+	row := q.db.QueryRowContext(ctx, startTransformationProcessingById, id)
+	var i Transformation
+	err := row.Scan(
+		&i.ID,
+		&i.ProjectID,
+		&i.TargetLanguage,
+		&i.TargetMedia,
+		&i.Transcript,
+		&i.IsSource,
+		&i.Status,
+		&i.Progress,
 		&i.Created,
 	)
 	return i, err
 }
 
 const updateProjectSourceMedia = `-- name: UpdateProjectSourceMedia :one
-UPDATE project SET source_media = $2 WHERE id = $1 RETURNING id, team_id, title, source_media, created
+UPDATE project SET source_media = $2 WHERE id = $1 RETURNING id, team_id, title, source_media, output_profile, loudness_target, source_language, transcription_prompt, transcription_vocabulary, transcription_model, created
 `
 
 type UpdateProjectSourceMediaParams struct {
@@ -880,6 +2841,49 @@ func (q *Queries) UpdateProjectSourceMedia(ctx context.Context, arg UpdateProjec
 		&i.TeamID,
 		&i.Title,
 		&i.SourceMedia,
+		&i.OutputProfile,
+		&i.LoudnessTarget,
+		&i.SourceLanguage,
+		&i.TranscriptionPrompt,
+		pq.Array(&i.TranscriptionVocabulary),
+		&i.TranscriptionModel,
+		&i.Created,
+	)
+	return i, err
+}
+
+const updateProjectTranscriptionSettings = `-- name: UpdateProjectTranscriptionSettings :one
+UPDATE project SET source_language = $2, transcription_prompt = $3, transcription_vocabulary = $4, transcription_model = $5 WHERE id = $1 RETURNING id, team_id, title, source_media, output_profile, loudness_target, source_language, transcription_prompt, transcription_vocabulary, transcription_model, created
+`
+
+type UpdateProjectTranscriptionSettingsParams struct {
+	ID                      int64
+	SourceLanguage          sql.NullString
+	TranscriptionPrompt     sql.NullString
+	TranscriptionVocabulary []string
+	TranscriptionModel      TranscriptionModel
+}
+
+func (q *Queries) UpdateProjectTranscriptionSettings(ctx context.Context, arg UpdateProjectTranscriptionSettingsParams) (Project, error) {
+	row := q.db.QueryRowContext(ctx, updateProjectTranscriptionSettings,
+		arg.ID,
+		arg.SourceLanguage,
+		arg.TranscriptionPrompt,
+		pq.Array(arg.TranscriptionVocabulary),
+		arg.TranscriptionModel,
+	)
+	var i Project
+	err := row.Scan(
+		&i.ID,
+		&i.TeamID,
+		&i.Title,
+		&i.SourceMedia,
+		&i.OutputProfile,
+		&i.LoudnessTarget,
+		&i.SourceLanguage,
+		&i.TranscriptionPrompt,
+		pq.Array(&i.TranscriptionVocabulary),
+		&i.TranscriptionModel,
 		&i.Created,
 	)
 	return i, err
@@ -912,7 +2916,7 @@ func (q *Queries) UpdateTargetMediaById(ctx context.Context, arg UpdateTargetMed
 }
 
 const updateTeamStripeCustomerIdByTeamId = `-- name: UpdateTeamStripeCustomerIdByTeamId :one
-UPDATE team SET stripe_customer_id = $2 WHERE id = $1 RETURNING id, slug, name, stripe_customer_id, team_type, created
+UPDATE team SET stripe_customer_id = $2 WHERE id = $1 RETURNING id, slug, name, stripe_customer_id, team_type, dubbing_cache_enabled, default_output_profile, translation_qa_mode, created
 `
 
 type UpdateTeamStripeCustomerIdByTeamIdParams struct {
@@ -929,6 +2933,9 @@ func (q *Queries) UpdateTeamStripeCustomerIdByTeamId(ctx context.Context, arg Up
 		&i.Name,
 		&i.StripeCustomerID,
 		&i.TeamType,
+		&i.DubbingCacheEnabled,
+		&i.DefaultOutputProfile,
+		&i.TranslationQaMode,
 		&i.Created,
 	)
 	return i, err
@@ -960,6 +2967,33 @@ func (q *Queries) UpdateTranscriptById(ctx context.Context, arg UpdateTranscript
 	return i, err
 }
 
+const updateTranscriptLanguageById = `-- name: UpdateTranscriptLanguageById :one
+UPDATE transformation SET transcript = $2, target_language = $3 WHERE id = $1 RETURNING id, project_id, target_language, target_media, transcript, is_source, status, progress, created
+`
+
+type UpdateTranscriptLanguageByIdParams struct {
+	ID             int64
+	Transcript     pqtype.NullRawMessage
+	TargetLanguage string
+}
+
+func (q *Queries) UpdateTranscriptLanguageById(ctx context.Context, arg UpdateTranscriptLanguageByIdParams) (Transformation, error) {
+	row := q.db.QueryRowContext(ctx, updateTranscriptLanguageById, arg.ID, arg.Transcript, arg.TargetLanguage)
+	var i Transformation
+	err := row.Scan(
+		&i.ID,
+		&i.ProjectID,
+		&i.TargetLanguage,
+		&i.TargetMedia,
+		&i.Transcript,
+		&i.IsSource,
+		&i.Status,
+		&i.Progress,
+		&i.Created,
+	)
+	return i, err
+}
+
 const updateTransformationProgressById = `-- name: UpdateTransformationProgressById :one
 UPDATE transformation SET progress = $2 WHERE id = $1 RETURNING id, project_id, target_language, target_media, transcript, is_source, status, progress, created
 `
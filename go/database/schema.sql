@@ -4,6 +4,18 @@ CREATE TYPE team_type AS ENUM ('PERSONAL', 'TEAM');
 DROP TYPE IF EXISTS membership_type CASCADE;
 CREATE TYPE membership_type AS ENUM ('OWNER', 'ADMIN', 'MEMBER');
 
+DROP TYPE IF EXISTS output_profile CASCADE;
+CREATE TYPE output_profile AS ENUM ('SOURCE', 'HD_720P', 'HD_1080P', 'UHD_4K', 'VERTICAL_9_16', 'WEBM_VP9', 'PRORES_PROXY');
+
+DROP TYPE IF EXISTS loudness_target CASCADE;
+CREATE TYPE loudness_target AS ENUM ('BROADCAST', 'YOUTUBE', 'PODCAST');
+
+DROP TYPE IF EXISTS transcription_model CASCADE;
+CREATE TYPE transcription_model AS ENUM ('TINY', 'BASE', 'SMALL', 'MEDIUM', 'LARGE_V2');
+
+DROP TYPE IF EXISTS translation_qa_mode CASCADE;
+CREATE TYPE translation_qa_mode AS ENUM ('OFF', 'FLAG', 'RETRANSLATE');
+
 DROP TABLE IF EXISTS userinfo CASCADE;
 CREATE TABLE userinfo (
   id BIGSERIAL PRIMARY KEY NOT NULL,
@@ -19,6 +31,9 @@ CREATE TABLE team (
   name TEXT NOT NULL,
   stripe_customer_id TEXT UNIQUE,
   team_type TEAM_TYPE NOT NULL,
+  dubbing_cache_enabled BOOLEAN NOT NULL,
+  default_output_profile OUTPUT_PROFILE NOT NULL,
+  translation_qa_mode TRANSLATION_QA_MODE NOT NULL,
   created TIMESTAMP NOT NULL
 );
 
@@ -27,7 +42,15 @@ CREATE TABLE subscription_plan (
   id BIGSERIAL PRIMARY KEY NOT NULL,
   team_id BIGINT REFERENCES team (id) ON DELETE CASCADE UNIQUE NOT NULL,
   stripe_subscription_id TEXT UNIQUE,
+  stripe_price_id TEXT,
+  plan_tier TEXT NOT NULL,
+  included_credits BIGINT NOT NULL,
+  subscription_status TEXT NOT NULL,
+  past_due_since TIMESTAMP,
   remaining_credits BIGINT NOT NULL,
+  overage_enabled BOOLEAN NOT NULL,
+  overage_spend_cap_usd BIGINT NOT NULL,
+  overage_credits_used BIGINT NOT NULL,
   created TIMESTAMP NOT NULL
 );
 
@@ -56,6 +79,12 @@ CREATE TABLE project (
   team_id BIGINT REFERENCES team (id) ON DELETE CASCADE NOT NULL,
   title TEXT NOT NULL,
   source_media TEXT NOT NULL,
+  output_profile OUTPUT_PROFILE NOT NULL,
+  loudness_target LOUDNESS_TARGET NOT NULL,
+  source_language TEXT,
+  transcription_prompt TEXT,
+  transcription_vocabulary TEXT[] NOT NULL,
+  transcription_model TRANSCRIPTION_MODEL NOT NULL,
   created TIMESTAMP NOT NULL
 );
 
@@ -71,3 +100,146 @@ CREATE TABLE transformation (
   progress DOUBLE PRECISION NOT NULL,
   created TIMESTAMP NOT NULL
 );
+
+DROP TYPE IF EXISTS reservation_status CASCADE;
+CREATE TYPE reservation_status AS ENUM ('HELD', 'CAPTURED', 'RELEASED');
+
+DROP TABLE IF EXISTS credit_reservation CASCADE;
+CREATE TABLE credit_reservation (
+  id BIGSERIAL PRIMARY KEY NOT NULL,
+  team_id BIGINT REFERENCES team (id) ON DELETE CASCADE NOT NULL,
+  transformation_id BIGINT REFERENCES transformation (id) ON DELETE SET NULL UNIQUE,
+  reserved_credits BIGINT NOT NULL,
+  reserved_overage_credits BIGINT NOT NULL,
+  captured_credits BIGINT NOT NULL,
+  status RESERVATION_STATUS NOT NULL,
+  overage_reported BOOLEAN NOT NULL,
+  created TIMESTAMP NOT NULL,
+  updated TIMESTAMP NOT NULL
+);
+
+DROP TYPE IF EXISTS stripe_event_status CASCADE;
+CREATE TYPE stripe_event_status AS ENUM ('RECEIVED', 'PROCESSED', 'IGNORED', 'FAILED');
+
+DROP TABLE IF EXISTS stripe_event CASCADE;
+CREATE TABLE stripe_event (
+  id BIGSERIAL PRIMARY KEY NOT NULL,
+  stripe_event_id TEXT UNIQUE NOT NULL,
+  event_type TEXT NOT NULL,
+  payload TEXT NOT NULL,
+  status STRIPE_EVENT_STATUS NOT NULL,
+  attempts BIGINT NOT NULL,
+  last_error TEXT,
+  created TIMESTAMP NOT NULL,
+  processed TIMESTAMP
+);
+
+DROP TYPE IF EXISTS upload_status CASCADE;
+CREATE TYPE upload_status AS ENUM ('PENDING', 'COMPLETED', 'ABORTED');
+
+DROP TABLE IF EXISTS upload_session CASCADE;
+CREATE TABLE upload_session (
+  id BIGSERIAL PRIMARY KEY NOT NULL,
+  team_id BIGINT REFERENCES team (id) ON DELETE CASCADE NOT NULL,
+  upload_key TEXT UNIQUE NOT NULL,
+  storage_upload_id TEXT NOT NULL,
+  file_name TEXT NOT NULL,
+  file_size BIGINT NOT NULL,
+  part_size BIGINT NOT NULL,
+  status UPLOAD_STATUS NOT NULL,
+  created TIMESTAMP NOT NULL,
+  completed TIMESTAMP
+);
+
+DROP TYPE IF EXISTS media_asset_kind CASCADE;
+CREATE TYPE media_asset_kind AS ENUM ('SOURCE', 'STEM', 'DUB', 'SUBTITLE', 'PREVIEW', 'THUMBNAIL');
+
+DROP TABLE IF EXISTS media_asset CASCADE;
+CREATE TABLE media_asset (
+  id BIGSERIAL PRIMARY KEY NOT NULL,
+  project_id BIGINT REFERENCES project (id) ON DELETE CASCADE NOT NULL,
+  transformation_id BIGINT REFERENCES transformation (id) ON DELETE CASCADE,
+  kind MEDIA_ASSET_KIND NOT NULL,
+  storage_key TEXT UNIQUE NOT NULL,
+  size_bytes BIGINT NOT NULL,
+  checksum TEXT NOT NULL,
+  duration_seconds DOUBLE PRECISION,
+  codec TEXT,
+  created TIMESTAMP NOT NULL
+);
+
+DROP TABLE IF EXISTS source_media_info CASCADE;
+CREATE TABLE source_media_info (
+  id BIGSERIAL PRIMARY KEY NOT NULL,
+  project_id BIGINT REFERENCES project (id) ON DELETE CASCADE UNIQUE NOT NULL,
+  container TEXT NOT NULL,
+  duration_seconds DOUBLE PRECISION NOT NULL,
+  video_codec TEXT NOT NULL,
+  width BIGINT NOT NULL,
+  height BIGINT NOT NULL,
+  frame_rate DOUBLE PRECISION NOT NULL,
+  audio_codec TEXT NOT NULL,
+  audio_channels BIGINT NOT NULL,
+  channel_layout TEXT NOT NULL,
+  sample_rate BIGINT NOT NULL,
+  loudness_lufs DOUBLE PRECISION,
+  streams JSONB NOT NULL,
+  created TIMESTAMP NOT NULL
+);
+
+DROP TABLE IF EXISTS retention_policy CASCADE;
+CREATE TABLE retention_policy (
+  id BIGSERIAL PRIMARY KEY NOT NULL,
+  team_id BIGINT REFERENCES team (id) ON DELETE CASCADE NOT NULL,
+  kind MEDIA_ASSET_KIND NOT NULL,
+  retention_days BIGINT NOT NULL,
+  created TIMESTAMP NOT NULL,
+  UNIQUE (team_id, kind)
+);
+
+DROP TABLE IF EXISTS translation_model CASCADE;
+CREATE TABLE translation_model (
+  id BIGSERIAL PRIMARY KEY NOT NULL,
+  team_id BIGINT REFERENCES team (id) ON DELETE CASCADE NOT NULL,
+  source_language TEXT NOT NULL,
+  target_language TEXT NOT NULL,
+  position BIGINT NOT NULL,
+  provider TEXT NOT NULL,
+  model TEXT NOT NULL,
+  temperature DOUBLE PRECISION,
+  max_tokens BIGINT,
+  json_response BOOLEAN NOT NULL,
+  created TIMESTAMP NOT NULL,
+  UNIQUE (team_id, source_language, target_language, position)
+);
+
+DROP TABLE IF EXISTS translation_qa_segment CASCADE;
+CREATE TABLE translation_qa_segment (
+  id BIGSERIAL PRIMARY KEY NOT NULL,
+  transformation_id BIGINT REFERENCES transformation (id) ON DELETE CASCADE NOT NULL,
+  segment_id BIGINT NOT NULL,
+  source_text TEXT NOT NULL,
+  translated_text TEXT NOT NULL,
+  back_translation TEXT NOT NULL,
+  similarity DOUBLE PRECISION NOT NULL,
+  length_fit DOUBLE PRECISION NOT NULL,
+  score DOUBLE PRECISION NOT NULL,
+  flagged BOOLEAN NOT NULL,
+  retranslated BOOLEAN NOT NULL,
+  created TIMESTAMP NOT NULL,
+  UNIQUE (transformation_id, segment_id)
+);
+
+DROP TYPE IF EXISTS dubbing_cache_kind CASCADE;
+CREATE TYPE dubbing_cache_kind AS ENUM ('TRANSLATION', 'SPEECH');
+
+DROP TABLE IF EXISTS dubbing_cache_entry CASCADE;
+CREATE TABLE dubbing_cache_entry (
+  id BIGSERIAL PRIMARY KEY NOT NULL,
+  cache_key TEXT UNIQUE NOT NULL,
+  kind DUBBING_CACHE_KIND NOT NULL,
+  storage_key TEXT NOT NULL,
+  hits BIGINT NOT NULL,
+  created TIMESTAMP NOT NULL,
+  last_used TIMESTAMP NOT NULL
+);
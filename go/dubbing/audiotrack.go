@@ -0,0 +1,193 @@
+package dubbing
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"planetcastdev/ffmpegmiddleware"
+	"planetcastdev/utils"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// Voice clips are mixed into the track in batches, so a long video does not
+// open hundreds of inputs in a single ffmpeg process
+const voiceMixBatchSize = 64
+
+func getVoiceClipName(identifier string, id int64) string {
+	return "voice_" + getAudioFileName(identifier, id)
+}
+
+// getSegmentAudioWindowEnd returns how far the speech for a segment can run
+// when only the audio is replaced. Nothing is cut, so the line may use all
+// of the silence up to the next one.
+func getSegmentAudioWindowEnd(segments []Segment, idx int, videoDuration float64) float64 {
+	if idx+1 < len(segments) {
+		return math.Max(segments[idx+1].Start, segments[idx].End)
+	}
+	if videoDuration > segments[idx].End {
+		return videoDuration
+	}
+	return segments[idx].End + maxBorrowedSeconds
+}
+
+// planAudioStretch is planStretch for when the video cannot be touched. The
+// speech is sped up as far as it takes to fit, which keeps the track in sync
+// at the cost of sounding rushed.
+func planAudioStretch(speechDuration float64, naturalDuration float64, windowDuration float64) stretchPlan {
+	plan := planStretch(speechDuration, naturalDuration, windowDuration)
+
+	if plan.strategy == stretchSlowVideo || plan.strategy == stretchFreezeFrame {
+		plan.strategy = stretchTempo
+		plan.tempo = speechDuration / windowDuration
+		plan.videoSlowdown = 1
+		plan.freezeDuration = 0
+	}
+
+	return plan
+}
+
+// prepareVoiceClip fits the speech for a segment to its window and brings it
+// to the target loudness, ready to be placed on the audio track.
+func (d *Dubbing) prepareVoiceClip(ctx context.Context, segment Segment, identifier string, windowEnd float64, loudness ffmpegmiddleware.LoudnessTarget) error {
+
+	id := segment.Id
+
+	audioFileName := getAudioFileName(identifier, id)
+	originalAudioSegmentName := getVideoSegmentName(identifier, id) + ".mp3"
+	voiceClipName := getVoiceClipName(identifier, id)
+
+	defer utils.DeleteFiles([]string{audioFileName, originalAudioSegmentName})
+
+	speechDuration, err := ffmpegmiddleware.GetDuration(ctx, audioFileName)
+	if err != nil {
+		return fmt.Errorf("Could not get audio file duration: %s", err.Error())
+	}
+
+	plan := planAudioStretch(speechDuration, segment.End-segment.Start, windowEnd-segment.Start)
+	d.logger.Info(
+		"Planned voice stretch",
+		zap.Int64("segment_id", id),
+		zap.String("strategy", string(plan.strategy)),
+		zap.Float64("tempo", plan.tempo),
+	)
+
+	filters := []string{}
+	if plan.tempo != 1 {
+		filters = append(filters, d.getTempoFilter(ctx, plan.tempo))
+	}
+	filters = append(filters, loudness.Loudnorm(), "aresample=44100", "aformat=channel_layouts=stereo")
+
+	prepareVoice := ffmpegmiddleware.NewCommand().Threads(1).
+		Input(audioFileName).
+		AudioFilter(strings.Join(filters, ",")).
+		AudioCodec("libmp3lame").
+		Option("-q:a", "2").
+		Output(voiceClipName)
+	err = d.ffmpeg.Run(ctx, prepareVoice)
+	if err != nil {
+		utils.DeleteFiles([]string{voiceClipName})
+		return fmt.Errorf("Voice preparation failed: %s\n%s\n", err.Error(), prepareVoice)
+	}
+
+	return nil
+}
+
+// mixVoiceBatch places a batch of voice clips at the start of their
+// segments on a single track, silent everywhere else.
+func (d *Dubbing) mixVoiceBatch(ctx context.Context, segments []Segment, identifier string, outputFileName string) error {
+	ffmpegCmd := ffmpegmiddleware.NewCommand()
+
+	filters := []string{}
+	labels := ""
+	for i, s := range segments {
+		ffmpegCmd.Input(getVoiceClipName(identifier, s.Id))
+
+		delay := int64(math.Round(s.Start * 1000))
+		filters = append(filters, fmt.Sprintf("[%d:a]adelay=%d:all=1[v%d]", i, delay, i))
+		labels += fmt.Sprintf("[v%d]", i)
+	}
+	filters = append(filters, fmt.Sprintf("%samix=inputs=%d:duration=longest:normalize=0[voice]", labels, len(segments)))
+
+	ffmpegCmd.
+		FilterComplex(strings.Join(filters, ";")).
+		Map("[voice]").
+		AudioCodec("pcm_s16le").
+		Output(outputFileName)
+
+	err := d.ffmpeg.Run(ctx, ffmpegCmd)
+	if err != nil {
+		return fmt.Errorf("Could not mix voice track: %s", err.Error())
+	}
+
+	return nil
+}
+
+// replaceAudioTrack builds the dubbed video without cutting the source. The
+// voice clips are laid out on one track, mixed over the background with
+// ducking and muxed onto the original video, whose stream is copied, so the
+// picture cannot drift from the sound.
+func (d *Dubbing) replaceAudioTrack(ctx context.Context, segments []Segment, identifier string, loudness ffmpegmiddleware.LoudnessTarget) (string, error) {
+	voiceClips := []string{}
+	for _, s := range segments {
+		voiceClips = append(voiceClips, getVoiceClipName(identifier, s.Id))
+	}
+	defer utils.DeleteFiles(voiceClips)
+
+	batchFileNames := []string{}
+	defer func() { utils.DeleteFiles(batchFileNames) }()
+
+	for start := 0; start < len(segments); start += voiceMixBatchSize {
+		end := utils.MinOf(start+voiceMixBatchSize, len(segments))
+		batchFileName := fmt.Sprintf("%s_voice_%d.wav", identifier, len(batchFileNames))
+		batchFileNames = append(batchFileNames, batchFileName)
+
+		err := d.mixVoiceBatch(ctx, segments[start:end], identifier, batchFileName)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	finalOutput := identifier + "_dubbed.mp4"
+
+	ffmpegCmd := ffmpegmiddleware.NewCommand().
+		Input(identifier + ".mp4").
+		Input(identifier + "-demucs.mp3")
+
+	voiceLabels := ""
+	for i, batchFileName := range batchFileNames {
+		ffmpegCmd.Input(batchFileName)
+		voiceLabels += fmt.Sprintf("[%d:a]", i+2)
+	}
+
+	// The voice track is already at the target loudness, only the
+	// background is ducked under it. The mix is as long as the background,
+	// which is as long as the video.
+	filter := fmt.Sprintf(
+		"%samix=inputs=%d:duration=longest:normalize=0,asplit=2[voice][sidechain];"+
+			"[1:a]aresample=44100,aformat=channel_layouts=stereo[background];"+
+			"[background][sidechain]%s[ducked];"+
+			"[ducked][voice]amix=inputs=2:duration=first:normalize=0[mixed]",
+		voiceLabels, len(batchFileNames), duckingCompressor,
+	)
+
+	ffmpegCmd.
+		FilterComplex(filter).
+		Map("0:v:0").
+		Map("[mixed]").
+		VideoCodec("copy").
+		AudioCodec("aac").
+		Option("-ar", "48000", "-movflags", "+faststart").
+		Output(finalOutput).
+		OnProgress(d.ffmpeg.LogProgress("Replacing audio track", zap.String("identifier", identifier)))
+
+	d.logger.Info("Replacing audio track", zap.String("identifier", identifier), zap.Int("segments", len(segments)), zap.Int("voice_batches", len(batchFileNames)))
+
+	err := d.ffmpeg.Run(ctx, ffmpegCmd)
+	if err != nil {
+		return "", fmt.Errorf("Could not replace audio track: %s\n%s", err.Error(), ffmpegCmd)
+	}
+
+	return finalOutput, nil
+}
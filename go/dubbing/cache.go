@@ -0,0 +1,103 @@
+package dubbing
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"planetcastdev/database"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// getCacheKey hashes everything that decides a provider's output, so two
+// requests share a key only when they would get the same result.
+func getCacheKey(provider string, request ...interface{}) string {
+	hash := sha256.New()
+	hash.Write([]byte(provider))
+
+	for _, part := range request {
+		jsonBytes, _ := json.Marshal(part)
+		hash.Write([]byte{0})
+		hash.Write(jsonBytes)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// readCache returns the stored result for cacheKey. A missing entry or object
+// is a miss, the caller then asks the provider and writes the result back.
+func (d *Dubbing) readCache(ctx context.Context, kind database.DubbingCacheKind, cacheKey string) ([]byte, bool) {
+	entry, err := d.database.GetDubbingCacheEntryByCacheKey(ctx, cacheKey)
+	if err != nil {
+		return nil, false
+	}
+
+	body, err := d.storage.Get(ctx, entry.StorageKey)
+	if err != nil {
+		d.logger.Warn("Dubbing cache entry has no stored result", zap.Error(err), zap.String("storage_key", entry.StorageKey))
+		d.database.DeleteDubbingCacheEntryById(ctx, entry.ID)
+		return nil, false
+	}
+	defer body.Close()
+
+	content, err := io.ReadAll(body)
+	if err != nil {
+		return nil, false
+	}
+
+	d.database.RecordDubbingCacheHitById(ctx, entry.ID)
+	d.logger.Debug("Dubbing cache hit", zap.String("kind", string(kind)), zap.String("cache_key", cacheKey))
+
+	return content, true
+}
+
+// writeCache stores a provider's result. Failing to cache never fails the
+// job, the result is only logged and dropped.
+func (d *Dubbing) writeCache(ctx context.Context, kind database.DubbingCacheKind, cacheKey string, content []byte) {
+	storageKey := fmt.Sprintf("cache/%s/%s", strings.ToLower(string(kind)), cacheKey)
+
+	err := d.storage.Put(ctx, storageKey, bytes.NewReader(content))
+	if err == nil {
+		err = d.database.CreateDubbingCacheEntry(ctx, database.CreateDubbingCacheEntryParams{
+			CacheKey:   cacheKey,
+			Kind:       kind,
+			StorageKey: storageKey,
+		})
+	}
+
+	if err != nil {
+		d.logger.Error("Could not write dubbing cache entry", zap.Error(err), zap.String("kind", string(kind)), zap.String("cache_key", cacheKey))
+	}
+}
+
+type CacheStats struct {
+	Kind    database.DubbingCacheKind
+	Entries int64
+	Hits    int64
+	HitRate float64
+}
+
+// GetCacheStats reports how often cached results were reused. Every miss
+// stores a new entry, so entries plus hits is the number of lookups.
+func (d *Dubbing) GetCacheStats(ctx context.Context) ([]CacheStats, error) {
+	rows, err := d.database.GetDubbingCacheStats(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Could not fetch dubbing cache stats: %s", err.Error())
+	}
+
+	stats := []CacheStats{}
+	for _, row := range rows {
+		hitRate := 0.0
+		if row.Entries+row.Hits > 0 {
+			hitRate = float64(row.Hits) / float64(row.Entries+row.Hits)
+		}
+		stats = append(stats, CacheStats{Kind: row.Kind, Entries: row.Entries, Hits: row.Hits, HitRate: hitRate})
+	}
+
+	return stats, nil
+}
@@ -2,146 +2,94 @@ package dubbing
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
+	"planetcastdev/ffmpegmiddleware"
 	"planetcastdev/utils"
+	"strconv"
 	"strings"
 
-	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
 
-func (d *Dubbing) concatSegments(ctx context.Context, segments []Segment, identifier string) (string, error) {
-
-	batchSize := 5
-	batchFiles := []string{}
-
-	for i := 0; i < len(segments); i += batchSize {
-		end := i + batchSize
-		if end > len(segments) {
-			end = len(segments)
-		}
-
-		batch := segments[i:end]
-		batchIdentifier := fmt.Sprintf("%s_batch%d_%s", identifier, i/batchSize, uuid.NewString())
+// withIntermediateFormat encodes every clip on the timeline the same way, so
+// the concat demuxer can join them without encoding the video again.
+func withIntermediateFormat(cmd *ffmpegmiddleware.Command, frameRate float64) *ffmpegmiddleware.Command {
+	cmd.
+		VideoCodec("libx264").
+		Option("-preset", "veryfast", "-crf", "18", "-pix_fmt", "yuv420p", "-video_track_timescale", "90000")
 
-		err := d.concatBatchSegments(ctx, batch, batchIdentifier, identifier)
-		if err != nil {
-			return "", err
-		}
-
-		d.logger.Info("Segments concatonated successfully")
-		batchFiles = append(batchFiles, batchIdentifier+"_dubbed.mp4")
-	}
-
-	// Now we need to concatenate the batch files
-	finalOutput, err := d.concatBatchFiles(ctx, batchFiles, identifier, batchSize)
-	if err != nil {
-		return "", err
+	if frameRate > 0 {
+		cmd.Option("-r", strconv.FormatFloat(frameRate, 'f', -1, 64))
 	}
 
-	return finalOutput, nil
+	return cmd.
+		AudioCodec("aac").
+		Option("-ar", "48000", "-ac", "2")
 }
 
-func (d *Dubbing) concatBatchSegments(ctx context.Context, batch []Segment, batchIdentifier string, identifier string) error {
-	inputList := []string{}
-	filterList := []string{}
-
-	for idx, s := range batch {
-		id := s.Id
-
-		videoSegmentName := getVideoSegmentName(identifier, id)
-		syncedSegmentName := "synced_" + videoSegmentName
-		inputList = append(inputList, fmt.Sprintf("-i file:'%s'", syncedSegmentName))
-		filterList = append(filterList, fmt.Sprintf("[%d:v][%d:a]", idx, idx))
-	}
-
-	filterList = append(filterList, fmt.Sprintf("concat=n=%d:v=1:a=1[v][a]", len(batch)))
-
-	inputArgs := strings.Join(inputList, " ")
-	filterComplex := strings.Join(filterList, "")
-
-	ffmpegCmd := fmt.Sprintf("ffmpeg -threads 1 %s -filter_complex '%s' -map '[v]' -map '[a]' -vsync 2 file:'%s_dubbed.mp4'",
-		inputArgs, filterComplex, batchIdentifier)
-
-	d.logger.Info("Concatenating segments", zap.String("ffmpeg_command", ffmpegCmd))
+func fileExists(fileName string) bool {
+	_, err := os.Stat(fileName)
+	return !errors.Is(err, fs.ErrNotExist)
+}
 
-	_, err := d.ffmpeg.Run(ctx, ffmpegCmd)
+// getTimelineClips lists the clips of the dubbed video in order. Every
+// segment has a synced clip, gaps only have a clip when there was silence
+// left over between lines.
+func getTimelineClips(segments []Segment, identifier string) []string {
+	clips := []string{}
 
-	fileList := []string{}
-	for _, s := range batch {
-		fileName := "synced_" + getVideoSegmentName(identifier, s.Id)
-		fileList = append(fileList, fileName)
-	}
-	utils.DeleteFiles(fileList)
+	for _, s := range segments {
+		videoSegmentName := getVideoSegmentName(identifier, s.Id)
 
-	if err != nil {
-		return fmt.Errorf("Could not concat segments: %s\n%s", err.Error(), ffmpegCmd)
+		if gap := "gap_" + videoSegmentName; fileExists(gap) {
+			clips = append(clips, gap)
+		}
+		clips = append(clips, "synced_"+videoSegmentName)
+		if tail := "tail_" + videoSegmentName; fileExists(tail) {
+			clips = append(clips, tail)
+		}
 	}
 
-	return nil
+	return clips
 }
 
-func (d *Dubbing) concatBatchFiles(ctx context.Context, batchFiles []string, identifier string, batchSize int) (string, error) {
-	for len(batchFiles) > 1 {
-		newBatchFiles := []string{}
-
-		for i := 0; i < len(batchFiles); i += batchSize {
-			end := i + batchSize
-			if end > len(batchFiles) {
-				end = len(batchFiles)
-			}
-
-			batch := batchFiles[i:end]
-			batchIdentifier := fmt.Sprintf("%s_finalbatch%d_%s", identifier, i/batchSize, uuid.NewString())
-
-			err := d.concatBatch(ctx, batch, batchIdentifier)
-			if err != nil {
-				return "", err
-			}
+// concatSegments joins the clips of every segment into the dubbed video in a
+// single pass, copying the streams rather than encoding them again.
+func (d *Dubbing) concatSegments(ctx context.Context, segments []Segment, identifier string) (string, error) {
+	clips := getTimelineClips(segments, identifier)
+	defer utils.DeleteFiles(clips)
 
-			d.logger.Info("Batch files concatenated successfully")
-			utils.DeleteFiles(batch)
-			newBatchFiles = append(newBatchFiles, batchIdentifier+"_dubbed.mp4")
-		}
+	listFileName := identifier + "_concat.txt"
+	defer utils.DeleteFiles([]string{listFileName})
 
-		batchFiles = newBatchFiles
+	lines := []string{}
+	for _, clip := range clips {
+		lines = append(lines, fmt.Sprintf("file '%s'", strings.ReplaceAll(clip, "'", `'\''`)))
 	}
 
-	// Rename the final batch file to the final output file
-	finalOutput := identifier + "_dubbed.mp4"
-	err := os.Rename(batchFiles[0], finalOutput)
+	err := os.WriteFile(listFileName, []byte(strings.Join(lines, "\n")+"\n"), 0644)
 	if err != nil {
-		return "", fmt.Errorf("Could not rename final output file: %s", err.Error())
+		return "", fmt.Errorf("Could not write concat list: %s", err.Error())
 	}
 
-	return finalOutput, nil
-}
-
-func (d *Dubbing) concatBatch(ctx context.Context, batch []string, batchIdentifier string) error {
-	inputList := []string{}
-	filterList := []string{}
-
-	for idx, fileName := range batch {
-		inputList = append(inputList, fmt.Sprintf("-i file:'%s'", fileName))
-		filterList = append(filterList, fmt.Sprintf("[%d:v][%d:a]", idx, idx))
-	}
-
-	filterList = append(filterList, fmt.Sprintf("concat=n=%d:v=1:a=1[v][a]", len(batch)))
-
-	inputArgs := strings.Join(inputList, " ")
-	filterComplex := strings.Join(filterList, "")
-
-	ffmpegCmd := fmt.Sprintf("ffmpeg -threads 1 %s -filter_complex '%s' -map '[v]' -map '[a]' -vsync 2 file:'%s_dubbed.mp4'",
-		inputArgs, filterComplex, batchIdentifier)
+	finalOutput := identifier + "_dubbed.mp4"
 
-	d.logger.Info("Concatenating batch", zap.String("batch_identifier", batchIdentifier), zap.String("ffmpeg_command", ffmpegCmd))
+	ffmpegCmd := ffmpegmiddleware.NewCommand().
+		Input(listFileName, "-f", "concat", "-safe", "0").
+		VideoCodec("copy").
+		AudioCodec("copy").
+		Option("-movflags", "+faststart").
+		Output(finalOutput)
 
-	_, err := d.ffmpeg.Run(ctx, ffmpegCmd)
+	d.logger.Info("Concatenating segments", zap.String("identifier", identifier), zap.Int("clips", len(clips)), zap.String("ffmpeg_command", ffmpegCmd.String()))
 
+	err = d.ffmpeg.Run(ctx, ffmpegCmd)
 	if err != nil {
-		return fmt.Errorf("Could not concat batch files: %s\n%s", err.Error(), ffmpegCmd)
+		return "", fmt.Errorf("Could not concat segments: %s\n%s", err.Error(), ffmpegCmd)
 	}
 
-	return nil
+	return finalOutput, nil
 }
@@ -3,7 +3,9 @@ package dubbing
 import (
 	"bytes"
 	"context"
+	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"os"
@@ -13,14 +15,15 @@ import (
 	"planetcastdev/email"
 	"planetcastdev/ffmpegmiddleware"
 	"planetcastdev/httpmiddleware"
+	"planetcastdev/mediaassets"
 	"planetcastdev/openaimiddleware"
 	"planetcastdev/replicatemiddleware"
+	"planetcastdev/scheduler"
 	"planetcastdev/storage"
 	"planetcastdev/utils"
 	"sort"
 	"strings"
 	"sync"
-	"time"
 
 	"github.com/tabbed/pqtype"
 	"go.uber.org/zap"
@@ -28,37 +31,46 @@ import (
 )
 
 type Dubbing struct {
-	storage    *storage.Storage
-	database   *database.Queries
-	logger     *zap.Logger
-	ffmpeg     *ffmpegmiddleware.Ffmpeg
-	email      *email.Email
-	openai     *openaimiddleware.OpenAI
-	replicate  *replicatemiddleware.Replicate
-	elevenlabs *elevenlabsmiddleware.ElevenLabs
+	storage     *storage.Storage
+	database    *database.Queries
+	logger      *zap.Logger
+	ffmpeg      *ffmpegmiddleware.Ffmpeg
+	email       *email.Email
+	openai      *openaimiddleware.OpenAI
+	replicate   *replicatemiddleware.Replicate
+	elevenlabs  *elevenlabsmiddleware.ElevenLabs
+	mediaAssets *mediaassets.MediaAssets
+	scheduler   *scheduler.Scheduler
+	http        *httpmiddleware.Client
 }
 
 type DubbingConnectProps struct {
-	Storage    *storage.Storage
-	Database   *database.Queries
-	Logger     *zap.Logger
-	Ffmpeg     *ffmpegmiddleware.Ffmpeg
-	Email      *email.Email
-	Openai     *openaimiddleware.OpenAI
-	Replicate  *replicatemiddleware.Replicate
-	ElevenLabs *elevenlabsmiddleware.ElevenLabs
+	Storage     *storage.Storage
+	Database    *database.Queries
+	Logger      *zap.Logger
+	Ffmpeg      *ffmpegmiddleware.Ffmpeg
+	Email       *email.Email
+	Openai      *openaimiddleware.OpenAI
+	Replicate   *replicatemiddleware.Replicate
+	ElevenLabs  *elevenlabsmiddleware.ElevenLabs
+	MediaAssets *mediaassets.MediaAssets
+	Scheduler   *scheduler.Scheduler
+	Http        *httpmiddleware.Client
 }
 
 func Connect(args DubbingConnectProps) *Dubbing {
 	return &Dubbing{
-		storage:    args.Storage,
-		database:   args.Database,
-		logger:     args.Logger,
-		ffmpeg:     args.Ffmpeg,
-		email:      args.Email,
-		openai:     args.Openai,
-		replicate:  args.Replicate,
-		elevenlabs: args.ElevenLabs,
+		storage:     args.Storage,
+		database:    args.Database,
+		logger:      args.Logger,
+		ffmpeg:      args.Ffmpeg,
+		email:       args.Email,
+		openai:      args.Openai,
+		replicate:   args.Replicate,
+		elevenlabs:  args.ElevenLabs,
+		mediaAssets: args.MediaAssets,
+		scheduler:   args.Scheduler,
+		http:        args.Http,
 	}
 }
 
@@ -73,7 +85,18 @@ func (d *Dubbing) CreateTransformation(
 	args CreateTransformationParams,
 ) (database.Transformation, error) {
 
-	transcriptPtr, err := d.getTranscript(ctx, args.FileName)
+	release, err := d.scheduler.AcquireJob(ctx, 0)
+	if err != nil {
+		return database.Transformation{}, err
+	}
+	defer release()
+
+	project, err := d.database.GetProjectById(ctx, args.ProjectID)
+	if err != nil {
+		return database.Transformation{}, fmt.Errorf("Could not find project: %s", err.Error())
+	}
+
+	transcriptPtr, err := d.getTranscript(ctx, args.FileName, GetTranscriptionSettings(project))
 
 	if err != nil {
 		d.logger.Error("Failed to generate transcript", zap.Error(err))
@@ -88,11 +111,50 @@ func (d *Dubbing) CreateTransformation(
 		return database.Transformation{}, err
 	}
 
-	demucsPtr, err := d.runDemucs(ctx, args.FileName)
+	stemFileName, err := d.separateBackground(ctx, args.FileName)
 	if err != nil {
-		d.logger.Error("Failed to run demucs", zap.Error(err))
 		return database.Transformation{}, err
 	}
+	defer utils.DeleteFiles([]string{stemFileName})
+
+	transformation, err := d.database.CreateTransformation(ctx, database.CreateTransformationParams{
+		ProjectID:      args.ProjectID,
+		TargetLanguage: strings.ToUpper(transcriptObj.Language),
+		TargetMedia:    args.FileName,
+		Transcript:     pqtype.NullRawMessage{RawMessage: jsonBytes, Valid: true},
+		IsSource:       args.IsSource,
+		Status:         "complete",
+		Progress:       100,
+	})
+
+	if err != nil {
+		d.logger.Error("Error occured", zap.Error(err))
+		return database.Transformation{}, err
+	}
+
+	_, err = d.mediaAssets.Store(ctx, mediaassets.StoreProps{
+		ProjectID:        args.ProjectID,
+		TransformationID: &transformation.ID,
+		Kind:             database.MediaAssetKindSTEM,
+		Key:              stemFileName,
+		FilePath:         stemFileName,
+	})
+	if err != nil {
+		d.logger.Error("Could not store background audio", zap.Error(err), zap.Int64("transformation_id", transformation.ID))
+		return database.Transformation{}, err
+	}
+
+	return transformation, nil
+}
+
+// separateBackground strips the vocals from a stored file with demucs and
+// mixes the remaining stems into one track, returning its path on disk.
+func (d *Dubbing) separateBackground(ctx context.Context, fileName string) (string, error) {
+	demucsPtr, err := d.runDemucs(ctx, fileName)
+	if err != nil {
+		d.logger.Error("Failed to run demucs", zap.Error(err))
+		return "", err
+	}
 	demucsObj := *demucsPtr
 
 	demucsFile := []*string{
@@ -112,65 +174,63 @@ func (d *Dubbing) CreateTransformation(
 		}
 		fileUrl := *filePtr
 
-		responseBody, err := httpmiddleware.HttpRequest(httpmiddleware.HttpRequestStruct{
+		demucsFileName := fmt.Sprintf("%s-demucs-%d.mp3", fileName, len(demucsFileNames))
+
+		err := d.http.DownloadFile(ctx, httpmiddleware.HttpRequestStruct{
 			Method: "GET",
 			Url:    fileUrl,
 			Headers: map[string]string{
-				"Content-Type": "application/json",
-				"Accept":       "audio/mp3",
+				"Accept": "audio/mp3",
 			},
-		})
-
-		demucsFileName := fmt.Sprintf("%s-demucs-%d.mp3", args.FileName, len(demucsFileNames))
-
+		}, demucsFileName)
 		if err != nil {
-			return database.Transformation{}, err
-		}
-		err = os.WriteFile(demucsFileName, responseBody, 0644)
-		if err != nil {
-			return database.Transformation{}, err
+			return "", err
 		}
 		demucsFileNames = append(demucsFileNames, demucsFileName)
 	}
 
-	//Mix files together. Upload to S3.
-	fileName := fmt.Sprintf("%s-demucs.mp3", args.FileName)
-	ffmpegFiles := []string{}
-	for _, fileName := range demucsFileNames {
-		ffmpegFiles = append(ffmpegFiles, fmt.Sprintf("-i file:'%s'", fileName))
+	//Mix files together
+	mixedFileName := fmt.Sprintf("%s-demucs.mp3", fileName)
+	ffmpegCmd := ffmpegmiddleware.NewCommand()
+	for _, demucsFileName := range demucsFileNames {
+		ffmpegCmd.Input(demucsFileName)
 	}
-	inputString := strings.Join(ffmpegFiles, " ")
-	ffmpegCmd := fmt.Sprintf("ffmpeg %s -filter_complex 'amix=inputs=%d:duration=longest' file:'%s'", inputString, len(demucsFileNames), fileName)
-	d.ffmpeg.Run(ctx, ffmpegCmd)
+	ffmpegCmd.
+		FilterComplex(fmt.Sprintf("amix=inputs=%d:duration=longest", len(demucsFileNames))).
+		Output(mixedFileName)
+	err = d.ffmpeg.Run(ctx, ffmpegCmd)
+
+	//Delete Files from Disk
+	utils.DeleteFiles(demucsFileNames)
 
-	file, err := os.Open(fileName)
 	if err != nil {
-		d.logger.Error("Error opening file", zap.Error(err))
+		utils.DeleteFiles([]string{mixedFileName})
+		return "", fmt.Errorf("Could not mix background audio: %s\n%s", err.Error(), ffmpegCmd)
 	}
-	defer file.Close()
 
-	d.storage.Upload(fileName, file)
+	return mixedFileName, nil
+}
 
-	//Delete Files from Disk
-	utils.DeleteFiles(demucsFileNames)
-	utils.DeleteFiles([]string{fileName})
+// restoreBackgroundStem separates the source again when its stem has expired
+// under a retention policy, and stores it for the next translation.
+func (d *Dubbing) restoreBackgroundStem(ctx context.Context, sourceTransformation database.Transformation, sourceKey string, filePath string) error {
+	stemFileName, err := d.separateBackground(ctx, sourceKey)
+	if err != nil {
+		return err
+	}
 
-	transformation, err := d.database.CreateTransformation(ctx, database.CreateTransformationParams{
-		ProjectID:      args.ProjectID,
-		TargetLanguage: strings.ToUpper(transcriptObj.Language),
-		TargetMedia:    args.FileName,
-		Transcript:     pqtype.NullRawMessage{RawMessage: jsonBytes, Valid: true},
-		IsSource:       args.IsSource,
-		Status:         "complete",
-		Progress:       100,
+	_, err = d.mediaAssets.Store(ctx, mediaassets.StoreProps{
+		ProjectID:        sourceTransformation.ProjectID,
+		TransformationID: &sourceTransformation.ID,
+		Kind:             database.MediaAssetKindSTEM,
+		Key:              stemFileName,
+		FilePath:         stemFileName,
 	})
-
 	if err != nil {
-		d.logger.Error("Error occured", zap.Error(err))
-		return database.Transformation{}, err
+		d.logger.Error("Could not store restored background audio", zap.Error(err), zap.Int64("transformation_id", sourceTransformation.ID))
 	}
 
-	return transformation, nil
+	return os.Rename(stemFileName, filePath)
 }
 
 type CreateTranslationProps struct {
@@ -190,39 +250,42 @@ func (d *Dubbing) CreateTranslation(
 	identifier := args.Identifier
 	targetTransformation := args.TargetTransformation
 
-	fileUrl := d.storage.GetFileLink(sourceTransformation.TargetMedia)
-	//download original media, then save it as identifier.mp4
-	responseBody, err := httpmiddleware.HttpRequest(httpmiddleware.HttpRequestStruct{
-		Method: "GET",
-		Url:    fileUrl,
-		Headers: map[string]string{
-			"Content-Type": "application/json",
-			"Accept":       "audio/mp4",
-		},
-	})
+	// Wait for a free job slot, the transformation stays in the starting
+	// state until then
+	release, err := d.scheduler.AcquireJob(ctx, targetTransformation.ID)
 	if err != nil {
-		return nil, fmt.Errorf("Error downloading original audio file from S3: %s", err.Error())
+		return nil, err
 	}
-	err = os.WriteFile(identifier+".mp4", responseBody, 0644)
+	defer release()
+
+	sourceAsset, err := d.database.GetMediaAssetByProjectIdKind(ctx, database.GetMediaAssetByProjectIdKindParams{
+		ProjectID: sourceTransformation.ProjectID,
+		Kind:      database.MediaAssetKindSOURCE,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("Error writing audio file: %s", err.Error())
+		return nil, fmt.Errorf("Could not find source media: %s", err.Error())
 	}
 
-	fileUrl = d.storage.GetFileLink(fmt.Sprintf("%s-demucs.mp3", sourceTransformation.TargetMedia))
-	responseBody, err = httpmiddleware.HttpRequest(httpmiddleware.HttpRequestStruct{
-		Method: "GET",
-		Url:    fileUrl,
-		Headers: map[string]string{
-			"Content-Type": "application/json",
-			"Accept":       "audio/mp3",
-		},
+	stemAsset, stemErr := d.database.GetMediaAssetByTransformationIdKind(ctx, database.GetMediaAssetByTransformationIdKindParams{
+		TransformationID: sql.NullInt64{Int64: sourceTransformation.ID, Valid: true},
+		Kind:             database.MediaAssetKindSTEM,
 	})
+
+	//download original media, then save it as identifier.mp4
+	err = d.storage.DownloadFile(ctx, sourceAsset.StorageKey, identifier+".mp4")
 	if err != nil {
-		return nil, fmt.Errorf("Error downloading demucs audio file from S3: %s", err.Error())
+		return nil, fmt.Errorf("Error downloading original audio file from storage: %s", err.Error())
+	}
+
+	if stemErr == nil {
+		err = d.storage.DownloadFile(ctx, stemAsset.StorageKey, identifier+"-demucs.mp3")
+	} else if errors.Is(stemErr, sql.ErrNoRows) {
+		err = d.restoreBackgroundStem(ctx, sourceTransformation, sourceAsset.StorageKey, identifier+"-demucs.mp3")
+	} else {
+		return nil, fmt.Errorf("Could not find background audio: %s", stemErr.Error())
 	}
-	err = os.WriteFile(identifier+"-demucs.mp3", responseBody, 0644)
 	if err != nil {
-		return nil, fmt.Errorf("Error writing demucs audio file: %s", err.Error())
+		return nil, fmt.Errorf("Error downloading demucs audio file from storage: %s", err.Error())
 	}
 
 	var whisperOutput WhisperOutput
@@ -247,6 +310,16 @@ func (d *Dubbing) CreateTranslation(
 		})
 	}
 
+	profile, err := ffmpegmiddleware.GetOutputProfile(string(projectObj.OutputProfile))
+	if err != nil {
+		return nil, err
+	}
+
+	loudness, err := ffmpegmiddleware.GetLoudnessTarget(string(projectObj.LoudnessTarget))
+	if err != nil {
+		return nil, err
+	}
+
 	fetchAndDubArgs := fetchAndDubProps{
 		segments:               sourceSegments,
 		projectId:              sourceTransformation.ProjectID,
@@ -255,29 +328,69 @@ func (d *Dubbing) CreateTranslation(
 		targetTransformationId: targetTransformation.ID,
 		lipSync:                args.LipSync,
 		gender:                 args.Gender,
+		useCache:               teamObj.DubbingCacheEnabled,
+		loudness:               loudness,
+		translationChain:       d.getTranslationChain(ctx, teamObj.ID, whisperOutput.Language, targetTransformation.TargetLanguage),
+		translationUsage:       newTranslationUsage(),
+		sourceLanguage:         whisperOutput.Language,
+		translationQaMode:      teamObj.TranslationQaMode,
 	}
+
+	// The QA report only covers the latest run of the transformation
+	d.database.DeleteTranslationQaSegmentsByTransformationId(ctx, targetTransformation.ID)
+
 	translatedSegmentsPtr, err := d.fetchAndDub(ctx, fetchAndDubArgs)
 	if err != nil {
 		return nil, fmt.Errorf("Error translating and fetching: %s", err.Error())
 	}
+	d.logger.Info(
+		"Translation token usage",
+		append(fetchAndDubArgs.translationUsage.fields(), zap.Int64("transformation_id", targetTransformation.ID))...,
+	)
 	translatedSegments := *translatedSegmentsPtr
 
 	if err != nil {
 		return nil, fmt.Errorf("Could not process translated segments " + err.Error())
 	}
 
-	newFileName, err := d.concatSegments(ctx, translatedSegments, identifier)
+	// Without lip sync the picture is unchanged, so only the audio track is
+	// rebuilt and the source video is copied
+	var newFileName string
+	if args.LipSync {
+		newFileName, err = d.concatSegments(ctx, translatedSegments, identifier)
+	} else {
+		newFileName, err = d.replaceAudioTrack(ctx, translatedSegments, identifier, loudness)
+	}
 	if err != nil {
-		d.logger.Error("Error concatenating segments", zap.Error(err))
+		return nil, fmt.Errorf("Could not assemble dubbed video: %s", err.Error())
 	}
 
-	file, err := os.Open(newFileName)
+	normalizedFileName, err := d.ffmpeg.NormalizeLoudness(ctx, newFileName, loudness)
 	if err != nil {
-		d.logger.Error("Error opening the file", zap.Error(err))
+		return nil, fmt.Errorf("Could not normalize dubbed video: %s", err.Error())
 	}
 
-	defer file.Close()
-	d.storage.Upload(newFileName, file)
+	renderedFileName, err := d.ffmpeg.RenderFile(ctx, normalizedFileName, profile)
+	if err != nil {
+		return nil, fmt.Errorf("Could not render dubbed video: %s", err.Error())
+	}
+
+	_, err = d.mediaAssets.Store(ctx, mediaassets.StoreProps{
+		ProjectID:        targetTransformation.ProjectID,
+		TransformationID: &targetTransformation.ID,
+		Kind:             database.MediaAssetKindDUB,
+		Key:              targetTransformation.TargetMedia,
+		FilePath:         renderedFileName,
+	})
+	// The concatenated file is still needed for transcription
+	for _, fileName := range []string{normalizedFileName, renderedFileName} {
+		if fileName != newFileName {
+			utils.DeleteFiles([]string{fileName})
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Could not store dubbed video: %s", err.Error())
+	}
 
 	// get the target text, and parse it
 	json.Unmarshal(targetTransformation.Transcript.RawMessage, &whisperOutput)
@@ -286,7 +399,11 @@ func (d *Dubbing) CreateTranslation(
 
 	// store the target text in db
 	jsonBytesUntimed, err := json.Marshal(whisperOutput)
-	transcriptPtr, err := d.getTranscript(ctx, newFileName)
+	// The dub's language is known, so it is not left to detection
+	transcriptPtr, err := d.getTranscript(ctx, newFileName, TranscriptionSettings{
+		SourceLanguage: targetTransformation.TargetLanguage,
+		Model:          projectObj.TranscriptionModel,
+	})
 	var transcriptObj WhisperOutput
 	var jsonBytesTimed []byte
 	if err == nil {
@@ -346,6 +463,13 @@ type fetchAndDubProps struct {
 	targetTransformationId int64
 	lipSync                bool
 	gender                 string
+	useCache               bool
+	loudness               ffmpegmiddleware.LoudnessTarget
+	videoDuration          float64
+	translationChain       []translationStep
+	translationUsage       *translationUsage
+	sourceLanguage         string
+	translationQaMode      database.TranslationQaMode
 }
 
 func (d *Dubbing) fetchAndDub(ctx context.Context, args fetchAndDubProps) (*[]Segment, error) {
@@ -363,15 +487,20 @@ func (d *Dubbing) fetchAndDub(ctx context.Context, args fetchAndDubProps) (*[]Se
 
 	wg.Add(len(args.segments))
 
-	maxWorkers := 4
-	sem := semaphore.NewWeighted(int64(maxWorkers))
+	sem := semaphore.NewWeighted(int64(d.scheduler.SegmentWorkers()))
 
-	frameRate, err := utils.GetVideoFileFrameRate(args.identifier + ".mp4")
+	frameRate, err := ffmpegmiddleware.GetFrameRate(ctx, args.identifier+".mp4")
 
 	if err != nil {
 		frameRate = 0
 	}
 
+	args.videoDuration, err = ffmpegmiddleware.GetDuration(ctx, args.identifier+".mp4")
+
+	if err != nil {
+		args.videoDuration = 0
+	}
+
 	for idx := range args.segments {
 
 		sem.Acquire(ctx, 1)
@@ -380,29 +509,11 @@ func (d *Dubbing) fetchAndDub(ctx context.Context, args fetchAndDubProps) (*[]Se
 			defer sem.Release(1)
 			defer wg.Done()
 
-			segmentRetires := 6
-			var translatedSeg *Segment
-			var err error
-
-			for segmentRetires > 0 {
-				sleepTime := utils.GetExponentialDelaySeconds(6 - segmentRetires)
-
-				translatedSeg, err = d.processSegment(ctx, idx, frameRate, args)
-				if err == nil {
-					break
-				} else {
-					segmentRetires -= 1
-					d.logger.Error(
-						"Could not process segment, retrying again after sleeping",
-						zap.Int("retries_left", segmentRetires),
-						zap.Error(err),
-						zap.Int("sleep_time", sleepTime),
-					)
-					time.Sleep(time.Duration(sleepTime) * time.Second)
-				}
-			}
-
+			// Requests made while processing are retried by the http client,
+			// an error here is not worth processing the segment again for
+			translatedSeg, err := d.processSegment(ctx, idx, frameRate, args)
 			if err != nil {
+				d.logger.Error("Could not process segment", zap.Error(err), zap.Int("segment_id", idx))
 				select {
 				case errChan <- err:
 				default:
@@ -474,74 +585,99 @@ func (d *Dubbing) processSegment(ctx context.Context, idx int, frameRate float64
 		afterOriginalSentences = append(afterOriginalSentences, seg.Text)
 	}
 
-	translatedSegment, err := d.translateSegment(ctx, segment, args.targetLanguage)
+	translatedSegment, err := d.translateSegment(ctx, translateSegmentProps{
+		segment:    segment,
+		targetLang: args.targetLanguage,
+		useCache:   args.useCache,
+		chain:      args.translationChain,
+		usage:      args.translationUsage,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("Failed to translated segment %d/%d: %s", idx+1, len(segments), err.Error())
 	}
+	translatedSegment = d.reviewTranslation(ctx, args, segment, translatedSegment)
 	logProgress("Translation Progress")
 
 	videoSegmentName := getVideoSegmentName(identifier, translatedSegment.Id)
 	originalAudioSegmentName := videoSegmentName + ".mp3"
 	demucsAudioSegmentName := videoSegmentName + "-demucs.mp3"
 
-	generateVideoClip := fmt.Sprintf("ffmpeg -threads 1 -i file:'%s.mp4' -ss %f -to %f file:'%s'", identifier, translatedSegment.Start, translatedSegment.End, videoSegmentName)
-	_, err = d.ffmpeg.Run(ctx, generateVideoClip)
-
-	generateAudioClip := fmt.Sprintf("ffmpeg -threads 1 -i file:'%s' -vn -acodec libmp3lame -q:a 4 file:'%s'", videoSegmentName, originalAudioSegmentName)
-	_, err = d.ffmpeg.Run(ctx, generateAudioClip)
-
-	generateDemucsAudioClip := fmt.Sprintf("ffmpeg -threads 1 -i file:'%s' -ss %f -to %f -vn -acodec libmp3lame -q:a 4 file:'%s'", identifier+"-demucs.mp3", translatedSegment.Start, translatedSegment.End, demucsAudioSegmentName)
-	_, err = d.ffmpeg.Run(ctx, generateDemucsAudioClip)
-
+	generateAudioClip := ffmpegmiddleware.NewCommand().Threads(1).
+		Input(identifier+".mp4").
+		Trim(translatedSegment.Start, translatedSegment.End).
+		NoVideo().
+		AudioCodec("libmp3lame").
+		Option("-q:a", "4").
+		Output(originalAudioSegmentName)
+	err = d.ffmpeg.Run(ctx, generateAudioClip)
 	if err != nil {
-		return nil, fmt.Errorf("Clip %d/%d extraction failed: %s\n%s\n", idx+1, len(segments), err.Error(), generateVideoClip)
+		return nil, fmt.Errorf("Clip %d/%d extraction failed: %s\n%s\n", idx+1, len(segments), err.Error(), generateAudioClip)
 	}
 	logProgress("Clip Extration")
 
-	err = d.fetchDubbedClip(ctx, *translatedSegment, identifier, args.targetLanguage, args.gender)
+	err = d.fetchDubbedClip(ctx, *translatedSegment, identifier, args.targetLanguage, args.gender, args.useCache)
 	if err != nil {
 		return nil, fmt.Errorf("Could fetch dubbed clip %d/%d: %s\n", idx+1, len(segments), err.Error())
 	}
 	logProgress("Audio Generation Progress")
 
-	err = d.dubVideoClip(ctx, *translatedSegment, identifier, frameRate)
+	// Without lip sync only the voice is needed, it is placed on the audio
+	// track once every segment is done
+	if !args.lipSync {
+		err = d.prepareVoiceClip(ctx, *translatedSegment, identifier, getSegmentAudioWindowEnd(segments, idx, args.videoDuration), args.loudness)
+		if err != nil {
+			return nil, fmt.Errorf("Could not prepare voice %d/%d: %s\n", idx+1, len(segments), err.Error())
+		}
+		logProgress("Dubbing Progress")
+		return translatedSegment, nil
+	}
+
+	// The video and background include the silence the line may borrow,
+	// the original audio is only the line itself as it is used for the voice
+	windowEnd := getSegmentWindowEnd(segments, idx, args.videoDuration)
+
+	generateDemucsAudioClip := ffmpegmiddleware.NewCommand().Threads(1).
+		Input(identifier+"-demucs.mp3").
+		Trim(translatedSegment.Start, windowEnd).
+		NoVideo().
+		AudioCodec("libmp3lame").
+		Option("-q:a", "4").
+		Output(demucsAudioSegmentName)
+	err = d.ffmpeg.Run(ctx, generateDemucsAudioClip)
+
+	if err != nil {
+		return nil, fmt.Errorf("Clip %d/%d extraction failed: %s\n%s\n", idx+1, len(segments), err.Error(), generateDemucsAudioClip)
+	}
+
+	err = d.dubVideoClip(ctx, *translatedSegment, identifier, frameRate, windowEnd, args.loudness)
 	if err != nil {
 		return nil, fmt.Errorf("Could not process clip %d/%d: %s\n", idx+1, len(segments), err.Error())
 	}
 	logProgress("Dubbing Progress")
 
-	if args.lipSync {
-		err = d.lipSyncClip(ctx, *translatedSegment, identifier)
-		if err != nil {
-			return nil, fmt.Errorf("Could not lip sync clip %d/%d: %s\n", idx+1, len(segments), err.Error())
-		}
-		logProgress("Lip Syncing Progress")
-	} else {
-		videoSegmentName := getVideoSegmentName(identifier, segment.Id)
-		dubbedVideoSegmentName := "dubbed_" + videoSegmentName
-		syncedVideoSegmentName := "synced_" + videoSegmentName
-		copyFileCmd := fmt.Sprintf("cp %s %s", dubbedVideoSegmentName, syncedVideoSegmentName)
-		utils.ExecCommand(copyFileCmd)
-		utils.DeleteFiles([]string{dubbedVideoSegmentName})
+	err = d.lipSyncClip(ctx, *translatedSegment, identifier, frameRate)
+	if err != nil {
+		return nil, fmt.Errorf("Could not lip sync clip %d/%d: %s\n", idx+1, len(segments), err.Error())
 	}
+	logProgress("Lip Syncing Progress")
 
 	var beforeSegment *Segment = nil
 	if idx > 0 {
-		beforeSegment = &segments[idx-1]
+		beforeSegment = &Segment{End: getSegmentWindowEnd(segments, idx-1, args.videoDuration)}
 	} else if idx == 0 {
 		beforeSegment = &Segment{End: 0}
 	}
 
-	err = d.addMissingInfo(ctx, addMissingInfoProps{identifier: identifier, currentSegment: *translatedSegment, beforeSegment: beforeSegment})
+	err = d.addMissingInfo(ctx, addMissingInfoProps{identifier: identifier, currentSegment: *translatedSegment, beforeSegment: beforeSegment, frameRate: frameRate})
 	if err != nil {
 		return nil, fmt.Errorf("Could not add missing info %d/%d: %s\n", idx+1, len(segments), err.Error())
 	}
 	logProgress("Added Missing Info")
 
-	videoDuration, err := utils.GetAudioFileDuration(identifier + ".mp4")
-	if err == nil && idx == len(segments)-1 && videoDuration-translatedSegment.End <= 0.5 {
+	videoDuration := args.videoDuration
+	if videoDuration > 0 && idx == len(segments)-1 && videoDuration-translatedSegment.End <= 0.5 {
 		flip := true
-		err = d.addMissingInfo(ctx, addMissingInfoProps{identifier: identifier, currentSegment: Segment{Id: translatedSegment.Id, Start: videoDuration - 0.1}, beforeSegment: translatedSegment, flip: &flip})
+		err = d.addMissingInfo(ctx, addMissingInfoProps{identifier: identifier, currentSegment: Segment{Id: translatedSegment.Id, Start: videoDuration - 0.1}, beforeSegment: &Segment{End: windowEnd}, frameRate: frameRate, flip: &flip})
 		if err != nil {
 			return nil, fmt.Errorf("Could not add missing info %d/%d: %s\n", idx+1, len(segments), err.Error())
 		}
@@ -555,9 +691,13 @@ type addMissingInfoProps struct {
 	currentSegment Segment
 	identifier     string
 	beforeSegment  *Segment
+	frameRate      float64
 	flip           *bool
 }
 
+// addMissingInfo cuts the video between two segments, with only the
+// background audio, into its own clip on the timeline. The clip comes before
+// the current segment, or after it when flip is set.
 func (d *Dubbing) addMissingInfo(ctx context.Context, args addMissingInfoProps) error {
 
 	if args.beforeSegment == nil {
@@ -573,50 +713,30 @@ func (d *Dubbing) addMissingInfo(ctx context.Context, args addMissingInfoProps)
 	}
 
 	videoSegmentName := getVideoSegmentName(args.identifier, args.currentSegment.Id)
-	beforeSegmentName := "before_" + videoSegmentName
-	syncedVideoSegmentName := "synced_" + videoSegmentName
-	demucsAudioSegmentName := "before_" + videoSegmentName + "-demucs.mp3"
-
-	mixedClipFileName := "mixed_" + beforeSegmentName
-
-	//extract the middle part with disabled audio
-	generateVideoClipCmd := fmt.Sprintf("ffmpeg -threads 1 -i file:'%s.mp4' -ss %f -to %f -af 'volume=0' file:'%s'", args.identifier, start, end, beforeSegmentName)
-	d.logger.Info("INTERIM FFMPEG CMD", zap.String("ffmpeg_cmd", generateVideoClipCmd))
-	_, err := d.ffmpeg.Run(ctx, generateVideoClipCmd)
-	if err != nil {
-		return fmt.Errorf("Could not extract interim segment: %s", err.Error())
-	}
-
-	generateDemucsAudioClip := fmt.Sprintf("ffmpeg -threads 1 -i file:'%s' -ss %f -to %f -vn -acodec libmp3lame -q:a 4 file:'%s'", identifier+"-demucs.mp3", start, end, demucsAudioSegmentName)
-	_, err = d.ffmpeg.Run(ctx, generateDemucsAudioClip)
-
-	dubVideoClip := fmt.Sprintf("ffmpeg -threads 1 -i file:'%s' -i file:'%s' -c:v copy -map 0:v:0 -map 1:a:0 file:'%s'",
-		beforeSegmentName, demucsAudioSegmentName, mixedClipFileName)
-	_, err = d.ffmpeg.Run(ctx, dubVideoClip)
-
-	batch := []string{mixedClipFileName, syncedVideoSegmentName}
+	gapSegmentName := "gap_" + videoSegmentName
 
 	if args.flip != nil && *args.flip == true {
-		batch = []string{syncedVideoSegmentName, mixedClipFileName}
+		gapSegmentName = "tail_" + videoSegmentName
 	}
 
-	outputFileName, err := d.concatBatchFiles(ctx, batch, args.identifier, 2)
+	generateGapClip := ffmpegmiddleware.NewCommand().Threads(1).
+		InputClip(identifier+".mp4", start, end).
+		InputClip(identifier+"-demucs.mp3", start, end).
+		Map("0:v:0").
+		Map("1:a:0")
+	withIntermediateFormat(generateGapClip, args.frameRate).
+		Output(gapSegmentName)
 
+	err := d.ffmpeg.Run(ctx, generateGapClip)
 	if err != nil {
-		return fmt.Errorf("Could not concat missing info: %s", err.Error())
-	}
-
-	err = os.Rename(outputFileName, syncedVideoSegmentName)
-	utils.DeleteFiles([]string{beforeSegmentName, demucsAudioSegmentName})
-
-	if err != nil {
-		return fmt.Errorf("Could not rename concated missing info output: %s", err.Error())
+		utils.DeleteFiles([]string{gapSegmentName})
+		return fmt.Errorf("Could not extract interim segment: %s\n%s", err.Error(), generateGapClip)
 	}
 
 	return nil
 }
 
-func (d *Dubbing) fetchDubbedClip(ctx context.Context, segment Segment, identifier string, language string, gender string) error {
+func (d *Dubbing) fetchDubbedClip(ctx context.Context, segment Segment, identifier string, language string, gender string, useCache bool) error {
 
 	id := segment.Id
 	audioFileName := getAudioFileName(identifier, id)
@@ -625,12 +745,28 @@ func (d *Dubbing) fetchDubbedClip(ctx context.Context, segment Segment, identifi
 
 	originalAudioSegmentName := videoSegmentName + ".mp3"
 
-	audioContent, err := d.elevenlabs.ElevenLabsMakeRequest(ctx, elevenlabsmiddleware.ElevenLabsRequestArgs{AudioFileName: originalAudioSegmentName, Text: segment.Text, Gender: gender})
+	requestArgs := elevenlabsmiddleware.ElevenLabsRequestArgs{AudioFileName: originalAudioSegmentName, Text: segment.Text, Gender: gender}
+	voiceId, voiceRequest := elevenlabsmiddleware.GetVoiceRequest(requestArgs)
+	cacheKey := getCacheKey("elevenlabs", voiceId, voiceRequest)
 
-	if err != nil {
-		return fmt.Errorf("Error reading response from ElevenLabs: %s", err.Error())
+	var audioContent []byte
+	cached := false
+	if useCache {
+		audioContent, cached = d.readCache(ctx, database.DubbingCacheKindSPEECH, cacheKey)
 	}
-	err = os.WriteFile(audioFileName, audioContent, 0644)
+
+	if cached == false {
+		var err error
+		audioContent, err = d.elevenlabs.ElevenLabsMakeRequest(ctx, requestArgs)
+		if err != nil {
+			return fmt.Errorf("Error reading response from ElevenLabs: %s", err.Error())
+		}
+		if useCache {
+			d.writeCache(ctx, database.DubbingCacheKindSPEECH, cacheKey, audioContent)
+		}
+	}
+
+	err := os.WriteFile(audioFileName, audioContent, 0644)
 	if err != nil {
 		return fmt.Errorf("Error writing audio file: %s", err.Error())
 	}
@@ -638,7 +774,7 @@ func (d *Dubbing) fetchDubbedClip(ctx context.Context, segment Segment, identifi
 
 }
 
-func (d *Dubbing) dubVideoClip(ctx context.Context, segment Segment, identifier string, frameRate float64) error {
+func (d *Dubbing) dubVideoClip(ctx context.Context, segment Segment, identifier string, frameRate float64, windowEnd float64, loudness ffmpegmiddleware.LoudnessTarget) error {
 
 	id := segment.Id
 
@@ -652,52 +788,73 @@ func (d *Dubbing) dubVideoClip(ctx context.Context, segment Segment, identifier
 	originalAudioSegmentName := videoSegmentName + ".mp3"
 	demucsAudioSegmentName := videoSegmentName + "-demucs.mp3"
 
-	start := segment.Start
-	end := segment.End
+	defer utils.DeleteFiles([]string{audioFileName, stretchAudioFileName, originalAudioSegmentName, demucsAudioSegmentName, mixedAudioFileName})
 
-	videoFileDuration := end - start
-	audioFileDuarion, err := utils.GetAudioFileDuration(audioFileName)
+	speechDuration, err := ffmpegmiddleware.GetDuration(ctx, audioFileName)
+	if err != nil {
+		return fmt.Errorf("Could not get audio file duration: %s", err.Error())
+	}
 
-	audioStretchRatio := videoFileDuration / audioFileDuarion
-	audioStretchRatio = math.Max(1/audioStretchRatio, 0.5)
+	plan := planStretch(speechDuration, segment.End-segment.Start, windowEnd-segment.Start)
+	d.logger.Info(
+		"Planned clip stretch",
+		zap.Int64("segment_id", id),
+		zap.String("strategy", string(plan.strategy)),
+		zap.Float64("tempo", plan.tempo),
+		zap.Float64("video_slowdown", plan.videoSlowdown),
+		zap.Float64("freeze_duration", plan.freezeDuration),
+	)
 
-	if err != nil {
-		d.logger.Error("Could not get audio file duration", zap.Error(err))
-		audioStretchRatio = 1
+	voiceFileName := audioFileName
+	if plan.tempo != 1 {
+		stretchAudioClip := ffmpegmiddleware.NewCommand().Threads(1).
+			Input(audioFileName).
+			AudioFilter(d.getTempoFilter(ctx, plan.tempo)).
+			Output(stretchAudioFileName)
+		err = d.ffmpeg.Run(ctx, stretchAudioClip)
+		if err != nil {
+			return fmt.Errorf("Clip stretching failed: %s\n%s\n", err.Error(), stretchAudioClip)
+		}
+		voiceFileName = stretchAudioFileName
 	}
 
+	mixAudioClip := ffmpegmiddleware.NewCommand().
+		Input(demucsAudioSegmentName).
+		Input(voiceFileName).
+		FilterComplex(getDuckingFilter(loudness)).
+		Map("[mixed]").
+		Output(mixedAudioFileName)
+	err = d.ffmpeg.Run(ctx, mixAudioClip)
 	if err != nil {
-		return fmt.Errorf("Clip stretching failed: %s\n%s\n", err.Error(), stretchAudioFileName)
+		return fmt.Errorf("Clip mixing failed: %s\n%s\n", err.Error(), mixAudioClip)
 	}
 
-	stretchAudioClip := fmt.Sprintf("ffmpeg -threads 1 -i file:'%s' -filter:a 'atempo=%f' file:'%s'", audioFileName, audioStretchRatio, stretchAudioFileName)
-	_, err = d.ffmpeg.Run(ctx, stretchAudioClip)
-
-	mixAudioClip := fmt.Sprintf(
-		"ffmpeg -i file:'%s' -i file:'%s' -filter_complex 'amix=inputs=2:duration=longest' file:'%s'",
-		demucsAudioSegmentName,
-		stretchAudioFileName,
-		mixedAudioFileName,
-	)
-	_, err = d.ffmpeg.Run(ctx, mixAudioClip)
-
-	dubVideoClip := fmt.Sprintf("ffmpeg -threads 1 -i file:'%s' -i file:'%s' -c:v copy -map 0:v:0 -map 1:a:0 file:'%s'",
-		videoSegmentName, mixedAudioFileName, dubbedVideoSegmentName)
-	_, err = d.ffmpeg.Run(ctx, dubVideoClip)
+	// The video is cut, stretched and encoded in one go so it is only
+	// encoded once before the final concatenation
+	dubVideoClip := ffmpegmiddleware.NewCommand().Threads(1).
+		InputClip(identifier+".mp4", segment.Start, windowEnd).
+		Input(mixedAudioFileName).
+		Map("0:v:0").
+		Map("1:a:0")
+	if plan.videoSlowdown != 1 || plan.freezeDuration > 0 {
+		dubVideoClip.VideoFilter(getVideoStretchFilter(plan))
+	}
+	withIntermediateFormat(dubVideoClip, frameRate).
+		Output(dubbedVideoSegmentName)
+	err = d.ffmpeg.Run(ctx, dubVideoClip)
 
 	if err != nil {
-		return fmt.Errorf("Clip dubbing failed: %s\n%s\n", err.Error(), dubVideoClip)
+		return fmt.Errorf("Clip dubbing failed: %s\n%s\n", err.Error(), dubVideoClip.String())
 	}
 
-	utils.DeleteFiles([]string{videoSegmentName, audioFileName, stretchAudioFileName, originalAudioSegmentName, demucsAudioSegmentName, mixedAudioFileName})
-
 	return nil
 }
 
-func (d *Dubbing) lipSyncClip(ctx context.Context, segment Segment, identifier string) error {
+func (d *Dubbing) lipSyncClip(ctx context.Context, segment Segment, identifier string, frameRate float64) error {
 
 	videoSegmentName := getVideoSegmentName(identifier, segment.Id)
 	dubbedVideoSegmentName := "dubbed_" + videoSegmentName
+	lipSyncedVideoSegmentName := "lipsynced_" + videoSegmentName
 	syncedVideoSegmentName := "synced_" + videoSegmentName
 
 	file, err := os.Open(dubbedVideoSegmentName)
@@ -724,43 +881,54 @@ func (d *Dubbing) lipSyncClip(ctx context.Context, segment Segment, identifier s
 
 	if err != nil {
 		d.logger.Error("Replicate Request Failed", zap.Error(err))
-		copyFileCmd := fmt.Sprintf("cp %s %s", dubbedVideoSegmentName, syncedVideoSegmentName)
-		utils.ExecCommand(copyFileCmd)
-		utils.DeleteFiles([]string{dubbedVideoSegmentName})
-		return nil
+		return os.Rename(dubbedVideoSegmentName, syncedVideoSegmentName)
 	}
 
-	//download original media, then save it as identifier.mp4
-	responseBody, err := httpmiddleware.HttpRequest(httpmiddleware.HttpRequestStruct{
+	//download lip synced media, then save it as the synced segment
+	err = d.http.DownloadFile(ctx, httpmiddleware.HttpRequestStruct{
 		Method: "GET",
 		Url:    outputUrl.(string),
 		Headers: map[string]string{
-			"Content-Type": "application/json",
-			"Accept":       "audio/mp4",
+			"Accept": "audio/mp4",
 		},
-	})
+	}, lipSyncedVideoSegmentName)
 
 	if err != nil {
 		return err
 	}
 
-	err = os.WriteFile(syncedVideoSegmentName, responseBody, 0644)
+	// The lip synced clip comes back in whatever format the model writes,
+	// so it is brought back to the timeline's format
+	encodeLipSyncedClip := ffmpegmiddleware.NewCommand().Threads(1).
+		Input(lipSyncedVideoSegmentName)
+	withIntermediateFormat(encodeLipSyncedClip, frameRate).
+		Output(syncedVideoSegmentName)
+	err = d.ffmpeg.Run(ctx, encodeLipSyncedClip)
+
+	utils.DeleteFiles([]string{dubbedVideoSegmentName, lipSyncedVideoSegmentName})
 
 	if err != nil {
-		return err
+		return fmt.Errorf("Could not encode lip synced clip: %s", err.Error())
 	}
 
-	utils.DeleteFiles([]string{dubbedVideoSegmentName})
-
 	return nil
 
 }
 
-func (d *Dubbing) translateSegment(
-	ctx context.Context,
-	segment Segment,
-	targetLang string,
-) (*Segment, error) {
+type translateSegmentProps struct {
+	segment    Segment
+	targetLang string
+	useCache   bool
+	chain      []translationStep
+	usage      *translationUsage
+	// Why an earlier translation of the segment was rejected, if one was
+	feedback string
+}
+
+func (d *Dubbing) translateSegment(ctx context.Context, args translateSegmentProps) (*Segment, error) {
+
+	segment := args.segment
+	targetLang := args.targetLang
 
 	timeTaken := segment.End - segment.Start
 
@@ -778,21 +946,117 @@ func (d *Dubbing) translateSegment(
 	userPrompt := fmt.Sprintf(
 		` Take a deep breath, and translate the following sentence to %s: '%s'. The original sentence was said in %f seconds, make sure that the translation can also be said in this time.`,
 		targetLang, segment.Text, timeTaken)
+	if args.feedback != "" {
+		userPrompt += " " + args.feedback
+	}
+
+	translation, err := d.translateWithChain(ctx, args.chain, systemPrompt, userPrompt, args.useCache, args.usage)
+	if err != nil {
+		return nil, err
+	}
+
+	segment.Text = translation
+	return &segment, nil
+}
+
+// translateWithChain asks each model of the chain in turn, falling back to
+// the next one when a model fails.
+func (d *Dubbing) translateWithChain(
+	ctx context.Context,
+	chain []translationStep,
+	systemPrompt string,
+	userPrompt string,
+	useCache bool,
+	usage *translationUsage,
+) (string, error) {
+
+	var err error
+	for _, step := range chain {
+		var translation string
+		translation, err = d.translateWithModel(ctx, step, systemPrompt, userPrompt, useCache, usage)
+		if err == nil {
+			return translation, nil
+		}
+
+		d.logger.Warn(
+			"Translation model failed",
+			zap.Error(err),
+			zap.String("provider", step.Provider),
+			zap.String("model", step.Model),
+		)
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	if err == nil {
+		return "", fmt.Errorf("No translation models configured")
+	}
+	return "", fmt.Errorf("Every translation model failed: %s", err.Error())
+}
+
+func (d *Dubbing) translateWithModel(
+	ctx context.Context,
+	step translationStep,
+	systemPrompt string,
+	userPrompt string,
+	useCache bool,
+	usage *translationUsage,
+) (string, error) {
 
 	retries := 5
 	chatGptInput := openaimiddleware.ChatRequestInput{
-		Model: "gpt-4",
-		Messages: []openaimiddleware.ChatCompletionMessage{
-			{Role: "system", Content: systemPrompt},
-			{Role: "user", Content: userPrompt},
-		},
+		Model:       step.Model,
+		Temperature: step.Temperature,
+		MaxTokens:   step.MaxTokens,
+	}
+	if step.JSONResponse {
+		systemPrompt += `    You will answer with a JSON object of the form {"translation": "<the translation>"}.
+  `
+		chatGptInput.ResponseFormat = openaimiddleware.JSONResponse
+	}
+	chatGptInput.Messages = []openaimiddleware.ChatCompletionMessage{
+		{Role: "system", Content: systemPrompt},
+		{Role: "user", Content: userPrompt},
 	}
 
-	chatResponse, err := d.openai.MakeAPIRequest(ctx, openaimiddleware.MakeAPIRequestProps{Retries: retries, RequestInput: chatGptInput})
+	cacheKey := getCacheKey(step.Provider, chatGptInput)
+	if useCache {
+		if translation, cached := d.readCache(ctx, database.DubbingCacheKindTRANSLATION, cacheKey); cached {
+			return string(translation), nil
+		}
+	}
+
+	chatResponse, err := d.openai.MakeAPIRequest(ctx, openaimiddleware.MakeAPIRequestProps{
+		Provider:     step.Provider,
+		Retries:      retries,
+		RequestInput: chatGptInput,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("Open AI Requests Failed: %s", err.Error())
+		return "", fmt.Errorf("Open AI Requests Failed: %s", err.Error())
+	}
+	if usage != nil {
+		usage.add(step, chatResponse.Usage)
 	}
 
-	segment.Text = chatResponse.Choices[0].Message.Content
-	return &segment, nil
+	translation := chatResponse.Choices[0].Message.Content
+	if step.JSONResponse {
+		var output struct {
+			Translation string `json:"translation"`
+		}
+		err = chatResponse.DecodeJSON(&output)
+		if err != nil {
+			return "", err
+		}
+		if output.Translation == "" {
+			return "", fmt.Errorf("Model returned an empty translation")
+		}
+		translation = output.Translation
+	}
+
+	if useCache {
+		d.writeCache(ctx, database.DubbingCacheKindTRANSLATION, cacheKey, []byte(translation))
+	}
+
+	return translation, nil
 }
@@ -0,0 +1,150 @@
+package dubbing
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+)
+
+type stretchStrategy string
+
+const (
+	// The speech already fits the original line
+	stretchNone stretchStrategy = "none"
+	// The speech runs on into the silence after the line
+	stretchBorrowGap stretchStrategy = "borrow_gap"
+	// The speech is sped up or slowed down, keeping its pitch
+	stretchTempo stretchStrategy = "tempo"
+	// The speech is sped up as far as it sounds natural and the video is
+	// slowed down to make up the rest
+	stretchSlowVideo stretchStrategy = "slow_video"
+	// As above, with the last frame held once the video cannot be slowed further
+	stretchFreezeFrame stretchStrategy = "freeze_frame"
+)
+
+const (
+	// Differences in length below this are not worth stretching for
+	stretchTolerance = 0.03
+	// Speech stays natural within this range of speeds
+	minSpeechTempo = 0.85
+	maxSpeechTempo = 1.25
+	// Slowing video down further than this is noticeable
+	maxVideoSlowdown = 1.15
+	// The most silence a line can take from the gap after it
+	maxBorrowedSeconds = 1.5
+)
+
+// stretchPlan is how a line of speech is fitted to its segment of video.
+type stretchPlan struct {
+	strategy stretchStrategy
+	// Speed the speech is played at, above 1 is faster
+	tempo float64
+	// Factor the video is slowed down by, 1 leaves it unchanged
+	videoSlowdown float64
+	// Seconds the last frame is held for
+	freezeDuration float64
+}
+
+// getSegmentWindowEnd returns where the video for a segment ends, which is the
+// end of its line plus the silence it may borrow from the gap after it. The
+// gap before the next segment starts here, so it only depends on the source
+// transcript and segments processed in parallel agree on it.
+func getSegmentWindowEnd(segments []Segment, idx int, videoDuration float64) float64 {
+	end := segments[idx].End
+
+	nextStart := videoDuration
+	if idx+1 < len(segments) {
+		nextStart = segments[idx+1].Start
+	}
+
+	gap := nextStart - end
+	if gap <= 0 {
+		return end
+	}
+	return end + math.Min(gap, maxBorrowedSeconds)
+}
+
+// planStretch picks how to fit speechDuration seconds of speech to a line
+// that originally took naturalDuration seconds, with windowDuration seconds
+// of video available once the borrowable silence is included. Strategies are
+// tried from least to most noticeable.
+func planStretch(speechDuration float64, naturalDuration float64, windowDuration float64) stretchPlan {
+	plan := stretchPlan{strategy: stretchNone, tempo: 1, videoSlowdown: 1}
+
+	if speechDuration <= 0 || naturalDuration <= 0 || windowDuration <= 0 {
+		return plan
+	}
+
+	// Short speech is slowed towards the original length, the rest of the
+	// line is left to the background
+	if speechDuration < naturalDuration*(1-stretchTolerance) {
+		plan.strategy = stretchTempo
+		plan.tempo = math.Max(speechDuration/naturalDuration, minSpeechTempo)
+		return plan
+	}
+
+	if speechDuration <= naturalDuration*(1+stretchTolerance) {
+		return plan
+	}
+
+	if speechDuration <= windowDuration {
+		plan.strategy = stretchBorrowGap
+		return plan
+	}
+
+	plan.tempo = speechDuration / windowDuration
+	if plan.tempo <= maxSpeechTempo {
+		plan.strategy = stretchTempo
+		return plan
+	}
+
+	plan.tempo = maxSpeechTempo
+	neededDuration := speechDuration / maxSpeechTempo
+
+	plan.strategy = stretchSlowVideo
+	plan.videoSlowdown = math.Min(neededDuration/windowDuration, maxVideoSlowdown)
+
+	remaining := neededDuration - windowDuration*plan.videoSlowdown
+	if remaining > stretchTolerance {
+		plan.strategy = stretchFreezeFrame
+		plan.freezeDuration = remaining
+	}
+
+	return plan
+}
+
+// getTempoFilter changes the speed of speech without changing its pitch.
+// rubberband sounds far better at large ratios but is only available when
+// ffmpeg is built with it, atempo is the fallback.
+func (d *Dubbing) getTempoFilter(ctx context.Context, tempo float64) string {
+	if d.ffmpeg.HasFilter(ctx, "rubberband") {
+		return fmt.Sprintf("rubberband=tempo=%f:pitchq=quality:formant=preserved", tempo)
+	}
+
+	// atempo only takes 0.5 to 2, larger changes are chained
+	filters := []string{}
+	for tempo > 2 {
+		filters = append(filters, "atempo=2")
+		tempo /= 2
+	}
+	for tempo < 0.5 {
+		filters = append(filters, "atempo=0.5")
+		tempo /= 0.5
+	}
+	filters = append(filters, fmt.Sprintf("atempo=%f", tempo))
+
+	return strings.Join(filters, ",")
+}
+
+// getVideoStretchFilter slows the video down and holds its last frame as the
+// plan asks.
+func getVideoStretchFilter(plan stretchPlan) string {
+	filters := []string{fmt.Sprintf("setpts=%f*PTS", plan.videoSlowdown)}
+
+	if plan.freezeDuration > 0 {
+		filters = append(filters, fmt.Sprintf("tpad=stop_mode=clone:stop_duration=%f", plan.freezeDuration))
+	}
+
+	return strings.Join(filters, ",")
+}
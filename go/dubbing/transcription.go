@@ -6,10 +6,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
-	"planetcastdev/utils"
+	"planetcastdev/database"
 	"strings"
-	"time"
 
+	"github.com/tabbed/pqtype"
 	"go.uber.org/zap"
 )
 
@@ -32,41 +32,82 @@ type Word struct {
 	Word  string  `json:"word"`
 }
 
-func (d *Dubbing) getTranscript(ctx context.Context, fileName string) (*WhisperOutput, error) {
+// Whisper model sizes, bigger ones are slower but make fewer mistakes
+var transcriptionModels = map[database.TranscriptionModel]string{
+	database.TranscriptionModelTINY:    "tiny",
+	database.TranscriptionModelBASE:    "base",
+	database.TranscriptionModelSMALL:   "small",
+	database.TranscriptionModelMEDIUM:  "medium",
+	database.TranscriptionModelLARGEV2: "large-v2",
+}
 
-	fileUrl := d.storage.GetFileLink(fileName)
+// TranscriptionSettings tune how a project's source is transcribed.
+type TranscriptionSettings struct {
+	// Skips language detection when set
+	SourceLanguage string
+	// Text the transcript is expected to follow on from, e.g. a description
+	// of the video
+	Prompt string
+	// Names and terms Whisper should spell the way they are given
+	Vocabulary []string
+	Model      database.TranscriptionModel
+}
 
-	retries := 5
+func GetTranscriptionSettings(project database.Project) TranscriptionSettings {
+	return TranscriptionSettings{
+		SourceLanguage: project.SourceLanguage.String,
+		Prompt:         project.TranscriptionPrompt.String,
+		Vocabulary:     project.TranscriptionVocabulary,
+		Model:          project.TranscriptionModel,
+	}
+}
 
-	var output any
+// getInitialPrompt joins the prompt and vocabulary into the text Whisper is
+// primed with.
+func (s TranscriptionSettings) getInitialPrompt() string {
+	parts := []string{}
+	if s.Prompt != "" {
+		parts = append(parts, s.Prompt)
+	}
+	if len(s.Vocabulary) > 0 {
+		parts = append(parts, strings.Join(s.Vocabulary, ", ")+".")
+	}
+	return strings.Join(parts, " ")
+}
 
-	for retries > 0 {
+func (d *Dubbing) getTranscript(ctx context.Context, fileName string, settings TranscriptionSettings) (*WhisperOutput, error) {
 
-		sleepTime := utils.GetExponentialDelaySeconds(5 - retries)
+	fileUrl := d.storage.GetFileLink(fileName)
 
-		replicateRequestBody := map[string]interface{}{
-			"input": map[string]interface{}{
-				"audio":           fileUrl,
-				"model":           "large-v2",
-				"word_timestamps": true,
-			},
-		}
-		jsonBody, err := json.Marshal(replicateRequestBody)
-		url := "https://api.replicate.com/v1/deployments/shehbajdhillon/whisper-model/predictions"
-		output, err = d.replicate.MakeRequest(ctx, bytes.NewBuffer(jsonBody), url)
+	model, ok := transcriptionModels[settings.Model]
+	if !ok {
+		model = transcriptionModels[database.TranscriptionModelLARGEV2]
+	}
 
-		if err == nil {
-			break
-		} else {
-			retries -= 1
-			d.logger.Error("Whisper request failed, retrying after sleeping", zap.Error(err), zap.Int("sleep_time", sleepTime), zap.Int("retries_left", retries))
-			time.Sleep(time.Duration(sleepTime) * time.Second)
-		}
+	input := map[string]interface{}{
+		"audio":           fileUrl,
+		"model":           model,
+		"word_timestamps": true,
+	}
+	if settings.SourceLanguage != "" {
+		input["language"] = strings.ToLower(settings.SourceLanguage)
+	}
+	if initialPrompt := settings.getInitialPrompt(); initialPrompt != "" {
+		input["initial_prompt"] = initialPrompt
 	}
 
-	if retries <= 0 {
-		d.logger.Error("Failed to transcribe whisper request")
-		return nil, fmt.Errorf("Failed to transcribe whisper request")
+	replicateRequestBody := map[string]interface{}{
+		"input": input,
+	}
+	jsonBody, err := json.Marshal(replicateRequestBody)
+	url := "https://api.replicate.com/v1/deployments/shehbajdhillon/whisper-model/predictions"
+
+	// Starting the prediction is already retried, a prediction that fails
+	// or times out is not worth running again
+	output, err := d.replicate.MakeRequest(ctx, bytes.NewBuffer(jsonBody), url)
+	if err != nil {
+		d.logger.Error("Failed to transcribe whisper request", zap.Error(err))
+		return nil, fmt.Errorf("Failed to transcribe whisper request: %s", err.Error())
 	}
 
 	outputJson, ok := output.(map[string]interface{})
@@ -88,7 +129,13 @@ func (d *Dubbing) getTranscript(ctx context.Context, fileName string) (*WhisperO
 		d.logger.Error("Could not parse whisper bytes to struct")
 		return nil, fmt.Errorf("Could not parse whisper bytes to struct")
 	}
-	d.logger.Info("Whisper request processes successfully for:", zap.String("fileName", fileName))
+	d.logger.Info("Whisper request processes successfully for:", zap.String("fileName", fileName), zap.String("model", model), zap.String("detected_language", whisperOutput.Language))
+
+	// Whisper may still report a language of its own, the one we were given
+	// is what the rest of the pipeline goes by
+	if settings.SourceLanguage != "" {
+		whisperOutput.Language = strings.ToLower(settings.SourceLanguage)
+	}
 
 	cleanedSegments := cleanSegments(&whisperOutput)
 	whisperOutput.Segments = cleanedSegments
@@ -96,6 +143,64 @@ func (d *Dubbing) getTranscript(ctx context.Context, fileName string) (*WhisperO
 	return &whisperOutput, nil
 }
 
+// Retranscribe transcribes a project's source again with its current
+// settings, e.g. after a misdetected language was corrected. Existing dubs
+// keep their translations, new ones start from the new transcript. The old
+// transcript is kept if transcription fails. The caller marks the source
+// transformation as processing, it is marked complete again once Retranscribe
+// returns.
+func (d *Dubbing) Retranscribe(ctx context.Context, sourceTransformation database.Transformation) (database.Transformation, error) {
+	projectId := sourceTransformation.ProjectID
+
+	defer d.database.UpdateTransformationStatusById(ctx, database.UpdateTransformationStatusByIdParams{
+		ID:     sourceTransformation.ID,
+		Status: "complete",
+	})
+
+	release, err := d.scheduler.AcquireJob(ctx, 0)
+	if err != nil {
+		return database.Transformation{}, err
+	}
+	defer release()
+
+	project, err := d.database.GetProjectById(ctx, projectId)
+	if err != nil {
+		return database.Transformation{}, fmt.Errorf("Could not find project: %s", err.Error())
+	}
+
+	sourceAsset, err := d.database.GetMediaAssetByProjectIdKind(ctx, database.GetMediaAssetByProjectIdKindParams{
+		ProjectID: projectId,
+		Kind:      database.MediaAssetKindSOURCE,
+	})
+	if err != nil {
+		return database.Transformation{}, fmt.Errorf("Could not find source media: %s", err.Error())
+	}
+
+	transcript, err := d.getTranscript(ctx, sourceAsset.StorageKey, GetTranscriptionSettings(project))
+	if err != nil {
+		d.logger.Error("Failed to regenerate transcript", zap.Error(err), zap.Int64("project_id", projectId))
+		return database.Transformation{}, err
+	}
+
+	jsonBytes, err := json.Marshal(transcript)
+	if err != nil {
+		return database.Transformation{}, fmt.Errorf("Could not parse transcript: %s", err.Error())
+	}
+
+	sourceTransformation, err = d.database.UpdateTranscriptLanguageById(ctx, database.UpdateTranscriptLanguageByIdParams{
+		ID:             sourceTransformation.ID,
+		Transcript:     pqtype.NullRawMessage{RawMessage: jsonBytes, Valid: true},
+		TargetLanguage: strings.ToUpper(transcript.Language),
+	})
+	if err != nil {
+		return database.Transformation{}, fmt.Errorf("Could not update transcript: %s", err.Error())
+	}
+
+	d.logger.Info("Retranscribed project", zap.Int64("project_id", projectId), zap.String("language", sourceTransformation.TargetLanguage))
+
+	return sourceTransformation, nil
+}
+
 func cleanSegments(whisperOutput *WhisperOutput) []Segment {
 	segments := whisperOutput.Segments
 	var newSegmentArray []Segment
@@ -141,35 +246,18 @@ type demucsOutput struct {
 func (d *Dubbing) runDemucs(ctx context.Context, fileName string) (*demucsOutput, error) {
 	fileUrl := d.storage.GetFileLink(fileName)
 
-	retries := 5
-
-	var output any
-
-	for retries > 0 {
-
-		sleepTime := utils.GetExponentialDelaySeconds(5 - retries)
-
-		replicateRequestBody := map[string]interface{}{
-			"input": map[string]interface{}{
-				"audio": fileUrl,
-			},
-		}
-		jsonBody, err := json.Marshal(replicateRequestBody)
-		url := "https://api.replicate.com/v1/deployments/shehbajdhillon/demucs/predictions"
-		output, err = d.replicate.MakeRequest(ctx, bytes.NewBuffer(jsonBody), url)
-
-		if err == nil {
-			break
-		} else {
-			retries -= 1
-			d.logger.Error("Demucs request failed, retrying after sleeping", zap.Error(err), zap.Int("sleep_time", sleepTime), zap.Int("retries_left", retries))
-			time.Sleep(time.Duration(sleepTime) * time.Second)
-		}
+	replicateRequestBody := map[string]interface{}{
+		"input": map[string]interface{}{
+			"audio": fileUrl,
+		},
 	}
+	jsonBody, err := json.Marshal(replicateRequestBody)
+	url := "https://api.replicate.com/v1/deployments/shehbajdhillon/demucs/predictions"
 
-	if retries <= 0 {
-		d.logger.Error("Failed to run demucs on input file")
-		return nil, fmt.Errorf("Failed to run demucs on input file")
+	output, err := d.replicate.MakeRequest(ctx, bytes.NewBuffer(jsonBody), url)
+	if err != nil {
+		d.logger.Error("Failed to run demucs on input file", zap.Error(err))
+		return nil, fmt.Errorf("Failed to run demucs on input file: %s", err.Error())
 	}
 
 	outputJson, ok := output.(map[string]interface{})
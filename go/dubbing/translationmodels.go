@@ -0,0 +1,165 @@
+package dubbing
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"planetcastdev/openaimiddleware"
+	"sort"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+const defaultTranslationModelChain = "openai:gpt-4"
+
+// translationStep is one model to try when translating. Steps are tried in
+// order until one succeeds.
+type translationStep struct {
+	Provider     string
+	Model        string
+	Temperature  *float64
+	MaxTokens    int64
+	JSONResponse bool
+}
+
+// parseTranslationModelChain reads a chain written as provider:model pairs,
+// separated by commas.
+func parseTranslationModelChain(value string) ([]translationStep, error) {
+	chain := []translationStep{}
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		provider, model, found := strings.Cut(entry, ":")
+		if !found || provider == "" || model == "" {
+			return nil, fmt.Errorf("Invalid translation model %s, expected provider:model", entry)
+		}
+		chain = append(chain, translationStep{Provider: strings.ToLower(provider), Model: model})
+	}
+
+	if len(chain) == 0 {
+		return nil, fmt.Errorf("Translation model chain is empty")
+	}
+	return chain, nil
+}
+
+func getDefaultTranslationChain() []translationStep {
+	value := os.Getenv("TRANSLATION_MODEL_CHAIN")
+	if value == "" {
+		value = defaultTranslationModelChain
+	}
+
+	chain, err := parseTranslationModelChain(value)
+	if err != nil {
+		chain, _ = parseTranslationModelChain(defaultTranslationModelChain)
+	}
+	return chain
+}
+
+// getLanguageMatch scores how closely a configured language pair fits the
+// job, -1 when it does not apply. An empty language matches any, and a
+// matching target language counts for more than a matching source.
+func getLanguageMatch(sourceLanguage string, targetLanguage string, configuredSource string, configuredTarget string) int {
+	score := 0
+
+	if configuredTarget != "" {
+		if !strings.EqualFold(configuredTarget, targetLanguage) {
+			return -1
+		}
+		score += 2
+	}
+
+	if configuredSource != "" {
+		if !strings.EqualFold(configuredSource, sourceLanguage) {
+			return -1
+		}
+		score += 1
+	}
+
+	return score
+}
+
+// getTranslationChain returns the models a team translates a language pair
+// with. The team's most specific configuration wins, teams without one use
+// the server's default chain.
+func (d *Dubbing) getTranslationChain(ctx context.Context, teamId int64, sourceLanguage string, targetLanguage string) []translationStep {
+	models, err := d.database.GetTranslationModelsByTeamId(ctx, teamId)
+	if err != nil {
+		d.logger.Error("Could not fetch translation models", zap.Error(err), zap.Int64("team_id", teamId))
+		return getDefaultTranslationChain()
+	}
+
+	bestScore := -1
+	for _, model := range models {
+		score := getLanguageMatch(sourceLanguage, targetLanguage, model.SourceLanguage, model.TargetLanguage)
+		if score > bestScore {
+			bestScore = score
+		}
+	}
+	if bestScore < 0 {
+		return getDefaultTranslationChain()
+	}
+
+	chain := []translationStep{}
+	sort.SliceStable(models, func(i, j int) bool { return models[i].Position < models[j].Position })
+	for _, model := range models {
+		if getLanguageMatch(sourceLanguage, targetLanguage, model.SourceLanguage, model.TargetLanguage) != bestScore {
+			continue
+		}
+
+		step := translationStep{
+			Provider:     model.Provider,
+			Model:        model.Model,
+			JSONResponse: model.JsonResponse,
+		}
+		if model.Temperature.Valid {
+			temperature := model.Temperature.Float64
+			step.Temperature = &temperature
+		}
+		if model.MaxTokens.Valid {
+			step.MaxTokens = model.MaxTokens.Int64
+		}
+		chain = append(chain, step)
+	}
+
+	return chain
+}
+
+func (d *Dubbing) HasTranslationProvider(name string) bool {
+	return d.openai.HasProvider(name)
+}
+
+type translationUsage struct {
+	mutex sync.Mutex
+	usage map[string]openaimiddleware.Usage
+}
+
+func newTranslationUsage() *translationUsage {
+	return &translationUsage{usage: map[string]openaimiddleware.Usage{}}
+}
+
+// add counts a request's tokens against the provider and model that served it.
+func (t *translationUsage) add(step translationStep, usage openaimiddleware.Usage) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	key := step.Provider + ":" + step.Model
+	total := t.usage[key]
+	total.Add(usage)
+	t.usage[key] = total
+}
+
+func (t *translationUsage) fields() []zap.Field {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	fields := []zap.Field{}
+	for model, usage := range t.usage {
+		fields = append(fields, zap.Any(model, usage))
+	}
+	return fields
+}
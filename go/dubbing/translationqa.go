@@ -0,0 +1,234 @@
+package dubbing
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"planetcastdev/database"
+	"planetcastdev/openaimiddleware"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultQaEmbeddingModel = "text-embedding-3-small"
+	// Back-translations less similar to the original than this are flagged
+	defaultQaMinSimilarity = 0.8
+	// Translations estimated to fit their line worse than this are flagged
+	defaultQaMinLengthFit = 0.8
+)
+
+// translationReview is how well a translation held up in QA.
+type translationReview struct {
+	backTranslation string
+	// Cosine similarity of the original and the back-translation
+	similarity float64
+	// 1 when the translation can be said in the original time, lower the
+	// further it runs over
+	lengthFit float64
+	score     float64
+	flagged   bool
+}
+
+func getQaThreshold(env string, fallback float64) float64 {
+	value, err := strconv.ParseFloat(os.Getenv(env), 64)
+	if err != nil || value < 0 {
+		return fallback
+	}
+	return value
+}
+
+// getLengthFit estimates whether a translation can be said in the time of the
+// original line. Characters stand in for speaking time, so this is rough
+// across scripts, but it catches translations that run far longer than the
+// line they replace.
+func getLengthFit(sourceText string, translation string) float64 {
+	sourceLength := utf8.RuneCountInString(strings.TrimSpace(sourceText))
+	if sourceLength == 0 {
+		return 1
+	}
+
+	// Speech this much faster than the original can still be fitted
+	maxTempo := maxSpeechTempo * maxVideoSlowdown
+	tempo := float64(utf8.RuneCountInString(strings.TrimSpace(translation))) / float64(sourceLength)
+	if tempo <= maxTempo {
+		return 1
+	}
+	return maxTempo / tempo
+}
+
+func getCosineSimilarity(a []float64, b []float64) float64 {
+	if len(a) != len(b) || len(a) == 0 {
+		return 0
+	}
+
+	dot, normA, normB := 0.0, 0.0, 0.0
+	for i := range a {
+		dot += a[i] * b[i]
+		normA += a[i] * a[i]
+		normB += b[i] * b[i]
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
+
+// backTranslate translates a translation back to the source language as
+// literally as possible, so changes in meaning show up.
+func (d *Dubbing) backTranslate(ctx context.Context, args fetchAndDubProps, translation string) (string, error) {
+	sourceLang := args.sourceLanguage
+	if sourceLang == "" {
+		sourceLang = "English"
+	}
+
+	systemPrompt := fmt.Sprintf(
+		` You are an expert translator that can translate any text to the %s language.
+    You will translate the input text as literally as possible, keeping its exact meaning.
+    You will not correct, explain or improve the text.
+    You will translate the input text and will only output the translation.
+  `, sourceLang)
+
+	userPrompt := fmt.Sprintf(` Translate the following sentence to %s: '%s'.`, sourceLang, translation)
+
+	return d.translateWithChain(ctx, args.translationChain, systemPrompt, userPrompt, args.useCache, args.translationUsage)
+}
+
+func (d *Dubbing) getSimilarity(ctx context.Context, args fetchAndDubProps, original string, backTranslation string) (float64, error) {
+	model := os.Getenv("TRANSLATION_QA_EMBEDDING_MODEL")
+	if model == "" {
+		model = defaultQaEmbeddingModel
+	}
+
+	embeddingResponse, err := d.openai.MakeEmbeddingRequest(ctx, openaimiddleware.MakeEmbeddingRequestProps{
+		Retries: 2,
+		RequestInput: openaimiddleware.EmbeddingRequestInput{
+			Model: model,
+			Input: []string{original, backTranslation},
+		},
+	})
+	if err != nil {
+		return 0, err
+	}
+	if args.translationUsage != nil {
+		args.translationUsage.add(translationStep{Provider: openaimiddleware.DefaultProvider, Model: model}, embeddingResponse.Usage)
+	}
+
+	return getCosineSimilarity(embeddingResponse.Data[0].Embedding, embeddingResponse.Data[1].Embedding), nil
+}
+
+// checkTranslation back-translates a segment and scores how much of the
+// original's meaning and timing survived.
+func (d *Dubbing) checkTranslation(ctx context.Context, args fetchAndDubProps, source Segment, translation string) (*translationReview, error) {
+	backTranslation, err := d.backTranslate(ctx, args, translation)
+	if err != nil {
+		return nil, fmt.Errorf("Could not back-translate segment: %s", err.Error())
+	}
+
+	similarity, err := d.getSimilarity(ctx, args, source.Text, backTranslation)
+	if err != nil {
+		return nil, fmt.Errorf("Could not score back-translation: %s", err.Error())
+	}
+
+	lengthFit := getLengthFit(source.Text, translation)
+
+	return &translationReview{
+		backTranslation: backTranslation,
+		similarity:      similarity,
+		lengthFit:       lengthFit,
+		score:           similarity * lengthFit,
+		flagged: similarity < getQaThreshold("TRANSLATION_QA_MIN_SIMILARITY", defaultQaMinSimilarity) ||
+			lengthFit < getQaThreshold("TRANSLATION_QA_MIN_LENGTH_FIT", defaultQaMinLengthFit),
+	}, nil
+}
+
+// getRetranslationFeedback tells the translator what was wrong with the
+// translation it is replacing.
+func getRetranslationFeedback(translation string, review *translationReview) string {
+	problems := []string{}
+	if review.similarity < getQaThreshold("TRANSLATION_QA_MIN_SIMILARITY", defaultQaMinSimilarity) {
+		problems = append(problems, fmt.Sprintf("it changed the meaning, it reads back as '%s'", review.backTranslation))
+	}
+	if review.lengthFit < getQaThreshold("TRANSLATION_QA_MIN_LENGTH_FIT", defaultQaMinLengthFit) {
+		problems = append(problems, "it is too long to be said in the original time")
+	}
+
+	return fmt.Sprintf(
+		"A previous translation, '%s', was rejected because %s. Keep the meaning of the original sentence and make sure it can be said in the same time.",
+		translation, strings.Join(problems, " and "))
+}
+
+// reviewTranslation runs QA on a translated segment when the team has it on,
+// storing the result for the transformation's QA report. Flagged segments
+// are translated again once if the team asked for it, keeping whichever
+// translation scored better. QA never fails the job, a segment that could
+// not be checked is dubbed as it is.
+func (d *Dubbing) reviewTranslation(ctx context.Context, args fetchAndDubProps, source Segment, translated *Segment) *Segment {
+	if args.translationQaMode == database.TranslationQaModeOFF || args.translationQaMode == "" {
+		return translated
+	}
+
+	review, err := d.checkTranslation(ctx, args, source, translated.Text)
+	if err != nil {
+		d.logger.Warn("Could not check translation", zap.Error(err), zap.Int64("transformation_id", args.targetTransformationId), zap.Int64("segment_id", source.Id))
+		return translated
+	}
+
+	retranslated := false
+	if review.flagged && args.translationQaMode == database.TranslationQaModeRETRANSLATE {
+		retry, err := d.translateSegment(ctx, translateSegmentProps{
+			segment:    source,
+			targetLang: args.targetLanguage,
+			useCache:   args.useCache,
+			chain:      args.translationChain,
+			usage:      args.translationUsage,
+			feedback:   getRetranslationFeedback(translated.Text, review),
+		})
+
+		var retryReview *translationReview
+		if err == nil {
+			retryReview, err = d.checkTranslation(ctx, args, source, retry.Text)
+		}
+
+		if err != nil {
+			d.logger.Warn("Could not retranslate flagged segment", zap.Error(err), zap.Int64("transformation_id", args.targetTransformationId), zap.Int64("segment_id", source.Id))
+		} else if retryReview.score > review.score {
+			translated = retry
+			review = retryReview
+			retranslated = true
+		}
+	}
+
+	_, err = d.database.CreateTranslationQaSegment(ctx, database.CreateTranslationQaSegmentParams{
+		TransformationID: args.targetTransformationId,
+		SegmentID:        source.Id,
+		SourceText:       source.Text,
+		TranslatedText:   translated.Text,
+		BackTranslation:  review.backTranslation,
+		Similarity:       review.similarity,
+		LengthFit:        review.lengthFit,
+		Score:            review.score,
+		Flagged:          review.flagged,
+		Retranslated:     retranslated,
+	})
+	if err != nil {
+		d.logger.Error("Could not save translation QA", zap.Error(err), zap.Int64("transformation_id", args.targetTransformationId), zap.Int64("segment_id", source.Id))
+	}
+
+	if review.flagged {
+		d.logger.Warn(
+			"Translation flagged by QA",
+			zap.Int64("transformation_id", args.targetTransformationId),
+			zap.Int64("segment_id", source.Id),
+			zap.Float64("similarity", review.similarity),
+			zap.Float64("length_fit", review.lengthFit),
+			zap.Bool("retranslated", retranslated),
+		)
+	}
+
+	return translated
+}
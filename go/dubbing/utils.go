@@ -1,6 +1,9 @@
 package dubbing
 
-import "fmt"
+import (
+	"fmt"
+	"planetcastdev/ffmpegmiddleware"
+)
 
 func getAudioFileName(identifier string, id int64) string {
 	audioFileName := fmt.Sprintf("%s_%d_audio_file.mp3", identifier, id)
@@ -11,3 +14,21 @@ func getVideoSegmentName(identifier string, id int64) string {
 	videoSegmentName := fmt.Sprintf("%s_%d_video_segment.mp4", identifier, id)
 	return videoSegmentName
 }
+
+// duckingCompressor lowers the background while the voice on its sidechain
+// is speaking.
+const duckingCompressor = "sidechaincompress=threshold=0.05:ratio=8:attack=20:release=300"
+
+// getDuckingFilter mixes a line of speech over its background. The voice is
+// brought to the target loudness and the background is compressed whenever
+// the voice is speaking, so speech stays intelligible without the music
+// dropping out between lines.
+func getDuckingFilter(loudness ffmpegmiddleware.LoudnessTarget) string {
+	return fmt.Sprintf(
+		"[1:a]%s,aresample=44100,aformat=channel_layouts=stereo,asplit=2[voice][sidechain];"+
+			"[0:a]aresample=44100,aformat=channel_layouts=stereo[background];"+
+			"[background][sidechain]%s[ducked];"+
+			"[ducked][voice]amix=inputs=2:duration=longest:normalize=0[mixed]",
+		loudness.Loudnorm(), duckingCompressor,
+	)
+}
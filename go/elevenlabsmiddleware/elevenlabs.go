@@ -9,8 +9,7 @@ import (
 	"mime/multipart"
 	"os"
 	"planetcastdev/httpmiddleware"
-	"planetcastdev/utils"
-	"time"
+	"planetcastdev/scheduler"
 
 	"go.uber.org/zap"
 )
@@ -32,13 +31,28 @@ type ElevenLabsRequestArgs struct {
 	Gender        string
 }
 
-func (e *ElevenLabs) ElevenLabsMakeRequest(ctx context.Context, args ElevenLabsRequestArgs) ([]byte, error) {
-
-	originalVoiceId := "XMQab44ShF40jzdHBoXu" //Fallback voice id
+// GetVoiceRequest returns the voice and request that ElevenLabsMakeRequest
+// sends for args, so callers can tell when two requests would sound the same.
+func GetVoiceRequest(args ElevenLabsRequestArgs) (string, VoiceRequest) {
+	voiceId := "XMQab44ShF40jzdHBoXu" //Fallback voice id
 	if args.Gender == "female" {
-		originalVoiceId = "21m00Tcm4TlvDq8ikWAM"
+		voiceId = "21m00Tcm4TlvDq8ikWAM"
 	}
 
+	return voiceId, VoiceRequest{
+		Text:    args.Text,
+		ModelID: "eleven_multilingual_v2",
+		VoiceSetting: VoiceSettings{
+			Stability:       1.0,
+			SimilarityBoost: 1.0,
+		},
+	}
+}
+
+func (e *ElevenLabs) ElevenLabsMakeRequest(ctx context.Context, args ElevenLabsRequestArgs) ([]byte, error) {
+
+	originalVoiceId, data := GetVoiceRequest(args)
+
 	voiceId := originalVoiceId
 
 	/**
@@ -49,19 +63,10 @@ func (e *ElevenLabs) ElevenLabsMakeRequest(ctx context.Context, args ElevenLabsR
 		}
 	  **/
 
-	data := VoiceRequest{
-		Text:    args.Text,
-		ModelID: "eleven_multilingual_v2",
-		VoiceSetting: VoiceSettings{
-			Stability:       1.0,
-			SimilarityBoost: 1.0,
-		},
-	}
-
 	audioContent, err := e.elevenLabsPerformTextToSpeech(ctx, data, voiceId)
 	/**
 		if voiceId != originalVoiceId {
-			e.elevenLabsDeleteClonedVoice(voiceId)
+			e.elevenLabsDeleteClonedVoice(ctx, voiceId)
 		}
 	  **/
 
@@ -79,44 +84,30 @@ func (e *ElevenLabs) elevenLabsPerformTextToSpeech(ctx context.Context, args Voi
 		e.logger.Error("Could not read ElevenLabs request body", zap.Error(err), zap.Any("request_body", args))
 		return nil, fmt.Errorf("Could not read ElevenLabs request body: %s", err.Error())
 	}
-	textToSpeechRetries := 5
 
-	if err := e.semaphore.Acquire(ctx, 1); err != nil {
-		return nil, fmt.Errorf("Failed to acquire semaphore.")
+	release, err := e.scheduler.Acquire(ctx, scheduler.ElevenLabs)
+	if err != nil {
+		return nil, err
 	}
-	defer e.semaphore.Release(1)
-
-	for textToSpeechRetries > 0 {
-
-		sleepTime := utils.GetExponentialDelaySeconds(5 - textToSpeechRetries)
-
-		audioContent, err := httpmiddleware.HttpRequest(httpmiddleware.HttpRequestStruct{
-			Method: "POST",
-			Url:    URL,
-			Body:   bytes.NewBuffer(payload),
-			Headers: map[string]string{
-				"Content-Type": "application/json",
-				"Accept":       "audio/mpeg",
-				"xi-api-key":   e.apiKey,
-			},
-		})
-
-		if err == nil {
-			return audioContent, nil
-		} else {
-			textToSpeechRetries -= 1
-			e.logger.Error(
-				"Request to Eleven Labs for text to speech failed, retrying after sleeping",
-				zap.Int("retries_left", textToSpeechRetries),
-				zap.Error(err),
-				zap.Int("sleep_time", sleepTime),
-			)
-			time.Sleep(time.Duration(sleepTime) * time.Second)
-		}
-
+	defer release()
+
+	audioContent, err := e.http.HttpRequest(ctx, httpmiddleware.HttpRequestStruct{
+		Method: "POST",
+		Url:    URL,
+		Body:   payload,
+		Headers: map[string]string{
+			"Content-Type": "application/json",
+			"Accept":       "audio/mpeg",
+			"xi-api-key":   e.apiKey,
+		},
+		Attempts: 5,
+	})
+	if err != nil {
+		e.logger.Error("Request to Eleven Labs for text to speech failed", zap.Error(err))
+		return nil, fmt.Errorf("Failed to generate audio: %s", err.Error())
 	}
 
-	return nil, fmt.Errorf("Failed to generate audio")
+	return audioContent, nil
 }
 
 type ElevenLabsVoiceCloneResponse struct {
@@ -125,8 +116,6 @@ type ElevenLabsVoiceCloneResponse struct {
 
 func (e *ElevenLabs) cloneVoice(ctx context.Context, fileName string) (string, error) {
 
-	voiceCloningRetries := 2
-
 	file, err := os.Open(fileName)
 	URL := "https://api.elevenlabs.io/v1/voices/add"
 
@@ -157,38 +146,18 @@ func (e *ElevenLabs) cloneVoice(ctx context.Context, fileName string) (string, e
 		return "", fmt.Errorf("Could write request for voice cloning: %s, %s", fileName, err.Error())
 	}
 
-	var responseBody []byte
-
-	for voiceCloningRetries > 0 {
-
-		sleepTime := utils.GetExponentialDelaySeconds(2 - voiceCloningRetries)
-
-		responseBody, err = httpmiddleware.HttpRequest(httpmiddleware.HttpRequestStruct{
-			Method: "POST",
-			Url:    URL,
-			Body:   requestBody,
-			Headers: map[string]string{
-				"Content-Type": writer.FormDataContentType(),
-				"Accept":       "application/json",
-				"xi-api-key":   e.apiKey,
-			},
-		})
-
-		if err != nil {
-			voiceCloningRetries -= 1
-			e.logger.Error(
-				"Request to Eleven Labs for voice cloning failed, retrying after sleeping",
-				zap.Int("retries_left", voiceCloningRetries),
-				zap.Error(err),
-				zap.Int("sleep_time", sleepTime),
-			)
-			time.Sleep(time.Duration(sleepTime) * time.Second)
-		} else {
-			break
-		}
-	}
-
-	if voiceCloningRetries <= 0 {
+	responseBody, err := e.http.HttpRequest(ctx, httpmiddleware.HttpRequestStruct{
+		Method: "POST",
+		Url:    URL,
+		Body:   requestBody.Bytes(),
+		Headers: map[string]string{
+			"Content-Type": writer.FormDataContentType(),
+			"Accept":       "application/json",
+			"xi-api-key":   e.apiKey,
+		},
+		Attempts: 2,
+	})
+	if err != nil {
 		return "", fmt.Errorf("Could not complete voice cloning network request: %s, %s", fileName, err.Error())
 	}
 
@@ -198,35 +167,23 @@ func (e *ElevenLabs) cloneVoice(ctx context.Context, fileName string) (string, e
 	return response.VoiceId, nil
 }
 
-func (e *ElevenLabs) elevenLabsDeleteClonedVoice(voiceId string) error {
+func (e *ElevenLabs) elevenLabsDeleteClonedVoice(ctx context.Context, voiceId string) error {
 
 	voiceIdUrl := fmt.Sprintf("https://api.elevenlabs.io/v1/voices/%s", voiceId)
-	deleteVoiceRetries := 2
-
-	for deleteVoiceRetries > 0 {
-		sleepTime := utils.GetExponentialDelaySeconds(2 - deleteVoiceRetries)
-		_, err := httpmiddleware.HttpRequest(httpmiddleware.HttpRequestStruct{
-			Url:    voiceIdUrl,
-			Method: "DELETE",
-			Headers: map[string]string{
-				"Content-Type": "application/json",
-				"xi-api-key":   e.apiKey,
-			},
-		})
-		if err == nil {
-			return nil
-		} else {
-			deleteVoiceRetries -= 1
-			e.logger.Error(
-				"Request to Eleven Labs for voice id deletion failed, retrying after sleeping",
-				zap.Int("retries_left", deleteVoiceRetries),
-				zap.Error(err),
-				zap.Int("sleep_time", sleepTime),
-				zap.String("voice_id", voiceId),
-			)
-			time.Sleep(time.Duration(sleepTime) * time.Second)
-		}
+
+	_, err := e.http.HttpRequest(ctx, httpmiddleware.HttpRequestStruct{
+		Url:    voiceIdUrl,
+		Method: "DELETE",
+		Headers: map[string]string{
+			"Content-Type": "application/json",
+			"xi-api-key":   e.apiKey,
+		},
+		Attempts: 2,
+	})
+	if err != nil {
+		e.logger.Error("Request to Eleven Labs for voice id deletion failed", zap.Error(err), zap.String("voice_id", voiceId))
+		return fmt.Errorf("Could not delete voice id from eleven labs %s", voiceId)
 	}
 
-	return fmt.Errorf("Could not delete voice id from eleven labs %s", voiceId)
+	return nil
 }
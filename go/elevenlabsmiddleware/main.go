@@ -1,26 +1,29 @@
 package elevenlabsmiddleware
 
 import (
-	"go.uber.org/zap"
-	"golang.org/x/sync/semaphore"
 	"os"
+	"planetcastdev/httpmiddleware"
+	"planetcastdev/scheduler"
+
+	"go.uber.org/zap"
 )
 
 type ElevenLabs struct {
 	logger      *zap.Logger
-	semaphore   *semaphore.Weighted
+	scheduler   *scheduler.Scheduler
+	http        *httpmiddleware.Client
 	apiKey      string
 	coquiApiKey string
 }
 
 type ElevenLabsConnectProps struct {
-	Logger *zap.Logger
+	Logger    *zap.Logger
+	Scheduler *scheduler.Scheduler
+	Http      *httpmiddleware.Client
 }
 
 func Connect(args ElevenLabsConnectProps) *ElevenLabs {
-	maxWorkers := 5
-	sem := semaphore.NewWeighted(int64(maxWorkers))
 	apiKey := os.Getenv("ELEVEN_LABS_KEY")
 	coquiApiKey := os.Getenv("COQUI_AI_KEY")
-	return &ElevenLabs{logger: args.Logger, semaphore: sem, apiKey: apiKey, coquiApiKey: coquiApiKey}
+	return &ElevenLabs{logger: args.Logger, scheduler: args.Scheduler, http: args.Http, apiKey: apiKey, coquiApiKey: coquiApiKey}
 }
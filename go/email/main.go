@@ -155,3 +155,85 @@ func (e *Email) DubbingEndedAlert(args DubbingAlertProps) {
 	}
 	e.sendEmail(sendEmailArgs)
 }
+
+type SubscriptionAlertProps struct {
+	PlanTier        string
+	TeamSlug        string
+	UserEmail       string
+	GracePeriodDays int
+}
+
+func (e *Email) SubscriptionStartedAlert(args SubscriptionAlertProps) {
+	html := fmt.Sprintf(
+		"Hey!<br /><br />Your %s subscription is now active and your credits are ready to use.<br /><br />You can manage your subscription through this link:<br /><br />www.planetcast.ai/dashboard/%s/settings/subscription<br /><br />Thanks,<br />Team PlanetCast",
+		args.PlanTier, args.TeamSlug,
+	)
+	sendEmailArgs := sendEmailProps{
+		Sender:    "PlanetCast <hello@planetcast.ai>",
+		Recipient: args.UserEmail,
+		HtmlBody:  html,
+		TextBody:  html,
+		Subject:   fmt.Sprintf("Welcome to PlanetCast %s", args.PlanTier),
+	}
+	e.sendEmail(sendEmailArgs)
+}
+
+func (e *Email) SubscriptionChangedAlert(args SubscriptionAlertProps) {
+	html := fmt.Sprintf(
+		"Hey!<br /><br />Your subscription has been changed to the %s plan. Your credits for the current billing period have been adjusted accordingly.<br /><br />You can manage your subscription through this link:<br /><br />www.planetcast.ai/dashboard/%s/settings/subscription<br /><br />Thanks,<br />Team PlanetCast",
+		args.PlanTier, args.TeamSlug,
+	)
+	sendEmailArgs := sendEmailProps{
+		Sender:    "PlanetCast <hello@planetcast.ai>",
+		Recipient: args.UserEmail,
+		HtmlBody:  html,
+		TextBody:  html,
+		Subject:   fmt.Sprintf("Your PlanetCast plan is now %s", args.PlanTier),
+	}
+	e.sendEmail(sendEmailArgs)
+}
+
+func (e *Email) SubscriptionPaymentFailedAlert(args SubscriptionAlertProps) {
+	html := fmt.Sprintf(
+		"Hey!<br /><br />We were unable to process the payment for your %s subscription. Please update your payment method within %d days, after that new dubbing will be paused until the payment goes through.<br /><br />You can update your payment method through this link:<br /><br />www.planetcast.ai/dashboard/%s/settings/subscription<br /><br />Thanks,<br />Team PlanetCast",
+		args.PlanTier, args.GracePeriodDays, args.TeamSlug,
+	)
+	sendEmailArgs := sendEmailProps{
+		Sender:    "PlanetCast <hello@planetcast.ai>",
+		Recipient: args.UserEmail,
+		HtmlBody:  html,
+		TextBody:  html,
+		Subject:   "Action Required: PlanetCast Payment Failed",
+	}
+	e.sendEmail(sendEmailArgs)
+}
+
+func (e *Email) SubscriptionReactivatedAlert(args SubscriptionAlertProps) {
+	html := fmt.Sprintf(
+		"Hey!<br /><br />Your payment went through and your %s subscription is active again. Dubbing is available for your team.<br /><br />You can access your dashboard through this link:<br /><br />www.planetcast.ai/dashboard/%s<br /><br />Thanks,<br />Team PlanetCast",
+		args.PlanTier, args.TeamSlug,
+	)
+	sendEmailArgs := sendEmailProps{
+		Sender:    "PlanetCast <hello@planetcast.ai>",
+		Recipient: args.UserEmail,
+		HtmlBody:  html,
+		TextBody:  html,
+		Subject:   "Your PlanetCast Subscription Is Active Again",
+	}
+	e.sendEmail(sendEmailArgs)
+}
+
+func (e *Email) SubscriptionCanceledAlert(args SubscriptionAlertProps) {
+	html := fmt.Sprintf(
+		"Hey!<br /><br />Your %s subscription has been canceled. Any credits you have left can still be used for dubbing.<br /><br />You can subscribe again at any time through this link:<br /><br />www.planetcast.ai/dashboard/%s/settings/subscription<br /><br />Thanks,<br />Team PlanetCast",
+		args.PlanTier, args.TeamSlug,
+	)
+	sendEmailArgs := sendEmailProps{
+		Sender:    "PlanetCast <hello@planetcast.ai>",
+		Recipient: args.UserEmail,
+		HtmlBody:  html,
+		TextBody:  html,
+		Subject:   "Your PlanetCast Subscription Has Been Canceled",
+	}
+	e.sendEmail(sendEmailArgs)
+}
@@ -0,0 +1,202 @@
+package ffmpegmiddleware
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+type commandInput struct {
+	options []string
+	path    string
+}
+
+// Command builds an ffmpeg invocation as a list of arguments. Nothing goes
+// through a shell, so file names reach ffmpeg exactly as they are.
+type Command struct {
+	threads       int
+	logLevel      string
+	inputs        []commandInput
+	filterComplex string
+	maps          []string
+	outputOptions []string
+	output        string
+	trimDuration  float64
+	sequential    bool
+	onProgress    func(progress float64)
+}
+
+func NewCommand() *Command {
+	return &Command{}
+}
+
+// Threads limits the threads ffmpeg uses, segments are processed in parallel
+// so each one only gets a single thread.
+func (c *Command) Threads(threads int) *Command {
+	c.threads = threads
+	return c
+}
+
+// LogLevel overrides the default of only logging errors, for filters that
+// report their results in the log.
+func (c *Command) LogLevel(level string) *Command {
+	c.logLevel = level
+	return c
+}
+
+// Input adds an input file. Options are placed before its -i and only apply
+// to that input.
+func (c *Command) Input(path string, options ...string) *Command {
+	c.inputs = append(c.inputs, commandInput{options: options, path: path})
+	return c
+}
+
+// InputClip adds an input that is only read between start and end, in
+// seconds. Unlike Trim this only cuts the one input, and seeking on the input
+// is still frame accurate when the output is encoded.
+func (c *Command) InputClip(path string, start float64, end float64) *Command {
+	c.trimDuration = math.Max(c.trimDuration, end-start)
+	return c.Input(path, "-ss", formatSeconds(start), "-to", formatSeconds(end))
+}
+
+func (c *Command) FilterComplex(filter string) *Command {
+	c.filterComplex = filter
+	return c
+}
+
+func (c *Command) Map(stream string) *Command {
+	c.maps = append(c.maps, stream)
+	return c
+}
+
+func (c *Command) VideoFilter(filter string) *Command {
+	return c.Option("-vf", filter)
+}
+
+func (c *Command) AudioFilter(filter string) *Command {
+	return c.Option("-af", filter)
+}
+
+func (c *Command) VideoCodec(codec string) *Command {
+	return c.Option("-c:v", codec)
+}
+
+func (c *Command) AudioCodec(codec string) *Command {
+	return c.Option("-c:a", codec)
+}
+
+func (c *Command) NoVideo() *Command {
+	return c.Option("-vn")
+}
+
+func (c *Command) NoAudio() *Command {
+	return c.Option("-an")
+}
+
+// Trim keeps the output between start and end, in seconds of the input.
+func (c *Command) Trim(start float64, end float64) *Command {
+	c.trimDuration = end - start
+	return c.Option("-ss", formatSeconds(start), "-to", formatSeconds(end))
+}
+
+// Sequential marks commands that play their inputs one after another, like
+// concatenation, so the output lasts as long as all inputs together.
+func (c *Command) Sequential() *Command {
+	c.sequential = true
+	return c
+}
+
+// OnProgress is called with the fraction of the output written so far, from
+// 0 to 1, while the command runs.
+func (c *Command) OnProgress(onProgress func(progress float64)) *Command {
+	c.onProgress = onProgress
+	return c
+}
+
+// Option adds output options that have no method of their own.
+func (c *Command) Option(args ...string) *Command {
+	c.outputOptions = append(c.outputOptions, args...)
+	return c
+}
+
+func (c *Command) Output(path string) *Command {
+	c.output = path
+	return c
+}
+
+// filePath stops ffmpeg from reading names such as "pipe:0" or
+// "http://..." as protocols.
+func filePath(path string) string {
+	return "file:" + path
+}
+
+func formatSeconds(seconds float64) string {
+	return strconv.FormatFloat(seconds, 'f', 6, 64)
+}
+
+func (c *Command) Args() []string {
+	logLevel := "error"
+	if c.logLevel != "" {
+		logLevel = c.logLevel
+	}
+
+	args := []string{"-nostdin", "-y", "-v", logLevel}
+
+	if c.threads > 0 {
+		args = append(args, "-threads", strconv.Itoa(c.threads))
+	}
+
+	for _, input := range c.inputs {
+		args = append(args, input.options...)
+		args = append(args, "-i", filePath(input.path))
+	}
+
+	if c.filterComplex != "" {
+		args = append(args, "-filter_complex", c.filterComplex)
+	}
+
+	for _, stream := range c.maps {
+		args = append(args, "-map", stream)
+	}
+
+	args = append(args, c.outputOptions...)
+	return append(args, filePath(c.output))
+}
+
+// String renders the command for logs, quoting arguments like a shell would.
+func (c *Command) String() string {
+	return formatCommandLine("ffmpeg", c.Args())
+}
+
+func formatCommandLine(name string, args []string) string {
+	quoted := []string{name}
+	for _, arg := range args {
+		if strings.ContainsAny(arg, " '\"[]();:,=") {
+			arg = "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+		}
+		quoted = append(quoted, arg)
+	}
+	return strings.Join(quoted, " ")
+}
+
+// runCommand executes name with args and returns what it wrote to stdout.
+// The process is killed if the context is cancelled.
+func runCommand(ctx context.Context, name string, args []string) (string, error) {
+	cmd := exec.CommandContext(ctx, name, args...)
+
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	if err != nil {
+		return "", fmt.Errorf("%s failed: %s, %s", name, err.Error(), strings.TrimSpace(stderr.String()))
+	}
+
+	return stdout.String(), nil
+}
@@ -0,0 +1,193 @@
+package ffmpegmiddleware
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type StreamInfo struct {
+	Index         int
+	CodecType     string
+	CodecName     string
+	Width         int
+	Height        int
+	FrameRate     float64
+	Channels      int
+	ChannelLayout string
+	SampleRate    int
+}
+
+// MediaInfo describes a media file as reported by ffprobe. The video and
+// audio fields are copied from the first stream of each type.
+type MediaInfo struct {
+	Container     string
+	Duration      float64
+	Streams       []StreamInfo
+	VideoCodec    string
+	Width         int
+	Height        int
+	FrameRate     float64
+	AudioCodec    string
+	AudioChannels int
+	ChannelLayout string
+	SampleRate    int
+}
+
+type probeOutput struct {
+	Streams []struct {
+		Index         int    `json:"index"`
+		CodecType     string `json:"codec_type"`
+		CodecName     string `json:"codec_name"`
+		Width         int    `json:"width"`
+		Height        int    `json:"height"`
+		FrameRate     string `json:"r_frame_rate"`
+		Channels      int    `json:"channels"`
+		ChannelLayout string `json:"channel_layout"`
+		SampleRate    string `json:"sample_rate"`
+	} `json:"streams"`
+	Format struct {
+		FormatName string `json:"format_name"`
+		Duration   string `json:"duration"`
+	} `json:"format"`
+}
+
+const inspectEntries = "format=format_name,duration:stream=index,codec_type,codec_name,width,height,r_frame_rate,channels,channel_layout,sample_rate"
+
+// Inspect reads the container and streams of a file on disk.
+func Inspect(ctx context.Context, fileName string) (*MediaInfo, error) {
+	return inspect(ctx, NewProbe(fileName).ShowEntries(inspectEntries).JSON())
+}
+
+// InspectURL reads the container and streams of a file served over http.
+// Only the headers are fetched, not the whole file.
+func InspectURL(ctx context.Context, url string) (*MediaInfo, error) {
+	return inspect(ctx, NewURLProbe(url).ShowEntries(inspectEntries).JSON())
+}
+
+func inspect(ctx context.Context, probe *ProbeCommand) (*MediaInfo, error) {
+	output, err := Probe(ctx, probe)
+	if err != nil {
+		return nil, fmt.Errorf("Could not read media file, it may be corrupt: %s", err.Error())
+	}
+
+	var parsed probeOutput
+	err = json.Unmarshal([]byte(output), &parsed)
+	if err != nil {
+		return nil, fmt.Errorf("Could not parse ffprobe output: %s", err.Error())
+	}
+
+	info := MediaInfo{Container: parsed.Format.FormatName}
+	info.Duration, _ = strconv.ParseFloat(parsed.Format.Duration, 64)
+
+	for _, s := range parsed.Streams {
+		stream := StreamInfo{
+			Index:         s.Index,
+			CodecType:     s.CodecType,
+			CodecName:     s.CodecName,
+			Width:         s.Width,
+			Height:        s.Height,
+			Channels:      s.Channels,
+			ChannelLayout: s.ChannelLayout,
+		}
+		stream.FrameRate, _ = parseFrameRateString(s.FrameRate)
+		stream.SampleRate, _ = strconv.Atoi(s.SampleRate)
+		info.Streams = append(info.Streams, stream)
+
+		if stream.CodecType == "video" && info.VideoCodec == "" {
+			info.VideoCodec = stream.CodecName
+			info.Width = stream.Width
+			info.Height = stream.Height
+			info.FrameRate = stream.FrameRate
+		}
+		if stream.CodecType == "audio" && info.AudioCodec == "" {
+			info.AudioCodec = stream.CodecName
+			info.AudioChannels = stream.Channels
+			info.ChannelLayout = stream.ChannelLayout
+			info.SampleRate = stream.SampleRate
+		}
+	}
+
+	return &info, nil
+}
+
+var supportedVideoCodecs = []string{"h264", "hevc", "vp8", "vp9", "av1", "mpeg4", "mpeg2video", "prores", "dnxhd", "mjpeg"}
+
+var supportedAudioCodecs = []string{"aac", "mp3", "opus", "vorbis", "ac3", "eac3", "flac", "alac"}
+
+func isSupportedCodec(codec string, supported []string) bool {
+	// Every flavour of uncompressed PCM decodes the same way
+	if strings.HasPrefix(codec, "pcm_") {
+		return true
+	}
+	for _, s := range supported {
+		if codec == s {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks that the file can be dubbed, which needs a video track and
+// an audio track in codecs we decode, and some actual length.
+func (m *MediaInfo) Validate() error {
+	if m.Duration <= 0 {
+		return fmt.Errorf("The file has no duration")
+	}
+	if m.VideoCodec == "" {
+		return fmt.Errorf("The file has no video track")
+	}
+	if m.AudioCodec == "" {
+		return fmt.Errorf("The file has no audio track")
+	}
+	if !isSupportedCodec(m.VideoCodec, supportedVideoCodecs) {
+		return fmt.Errorf("Unsupported video codec: %s", m.VideoCodec)
+	}
+	if !isSupportedCodec(m.AudioCodec, supportedAudioCodecs) {
+		return fmt.Errorf("Unsupported audio codec: %s", m.AudioCodec)
+	}
+	return nil
+}
+
+// MeasureLoudness returns the integrated loudness of the file's audio in
+// LUFS, measured over the whole file with the EBU R128 filter.
+func (f *Ffmpeg) MeasureLoudness(ctx context.Context, fileName string) (float64, error) {
+	statsFileName := uuid.NewString() + "_loudness.txt"
+	defer os.Remove(statsFileName)
+
+	ffmpegCmd := NewCommand().
+		Input(fileName).
+		NoVideo().
+		AudioFilter("ebur128=metadata=1,ametadata=mode=print:key=lavfi.r128.I:file="+statsFileName).
+		Option("-f", "null").
+		Output(os.DevNull).
+		OnProgress(f.LogProgress("Measuring loudness", zap.String("file_name", fileName)))
+
+	err := f.Run(ctx, ffmpegCmd)
+	if err != nil {
+		return 0, fmt.Errorf("Could not measure loudness: %s", err.Error())
+	}
+
+	stats, err := os.ReadFile(statsFileName)
+	if err != nil {
+		return 0, fmt.Errorf("Could not read loudness stats: %s", err.Error())
+	}
+
+	// The running value is printed for every frame, the last one covers the
+	// whole file
+	lines := strings.Split(strings.TrimSpace(string(stats)), "\n")
+	for i := len(lines) - 1; i >= 0; i-- {
+		value, found := strings.CutPrefix(lines[i], "lavfi.r128.I=")
+		if found {
+			return strconv.ParseFloat(strings.TrimSpace(value), 64)
+		}
+	}
+
+	return 0, fmt.Errorf("No loudness was measured for %s", fileName)
+}
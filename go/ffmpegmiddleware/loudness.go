@@ -0,0 +1,125 @@
+package ffmpegmiddleware
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// LoudnessTarget is the EBU R128 loudness a dub is mixed to.
+type LoudnessTarget struct {
+	Name string
+	// Integrated loudness in LUFS
+	Integrated float64
+	// Maximum true peak in dBTP
+	TruePeak float64
+	// Loudness range in LU
+	Range float64
+}
+
+var loudnessTargets = map[string]LoudnessTarget{
+	"BROADCAST": {Name: "BROADCAST", Integrated: -23, TruePeak: -1, Range: 15},
+	"YOUTUBE":   {Name: "YOUTUBE", Integrated: -14, TruePeak: -1, Range: 11},
+	"PODCAST":   {Name: "PODCAST", Integrated: -16, TruePeak: -1.5, Range: 9},
+}
+
+func GetLoudnessTarget(name string) (LoudnessTarget, error) {
+	target, ok := loudnessTargets[name]
+	if !ok {
+		return LoudnessTarget{}, fmt.Errorf("Unknown loudness target: %s", name)
+	}
+	return target, nil
+}
+
+// Loudnorm returns a single pass loudnorm filter for the target, which is
+// accurate enough for short clips such as a line of speech.
+func (t LoudnessTarget) Loudnorm() string {
+	return fmt.Sprintf("loudnorm=I=%g:TP=%g:LRA=%g", t.Integrated, t.TruePeak, t.Range)
+}
+
+type loudnormStats struct {
+	InputI       string `json:"input_i"`
+	InputTP      string `json:"input_tp"`
+	InputLRA     string `json:"input_lra"`
+	InputThresh  string `json:"input_thresh"`
+	TargetOffset string `json:"target_offset"`
+}
+
+// measureLoudnorm runs the first loudnorm pass, which only measures the file.
+func (f *Ffmpeg) measureLoudnorm(ctx context.Context, fileName string, target LoudnessTarget) (*loudnormStats, error) {
+	ffmpegCmd := NewCommand().
+		LogLevel("info").
+		Input(fileName).
+		NoVideo().
+		AudioFilter(target.Loudnorm()+":print_format=json").
+		Option("-f", "null").
+		Output(os.DevNull).
+		OnProgress(f.LogProgress("Measuring loudness for normalization", zap.String("file_name", fileName)))
+
+	output, err := f.RunWithLog(ctx, ffmpegCmd)
+	if err != nil {
+		return nil, err
+	}
+
+	// The stats are the last thing logged, as a JSON object
+	start := strings.LastIndex(output, "{")
+	end := strings.LastIndex(output, "}")
+	if start == -1 || end < start {
+		return nil, fmt.Errorf("No loudnorm stats in ffmpeg output")
+	}
+
+	var stats loudnormStats
+	err = json.Unmarshal([]byte(output[start:end+1]), &stats)
+	if err != nil {
+		return nil, fmt.Errorf("Could not parse loudnorm stats: %s", err.Error())
+	}
+
+	return &stats, nil
+}
+
+// NormalizeLoudness brings the audio of a finished video to the target with a
+// two pass loudnorm, so the whole programme is measured before any gain is
+// applied. The video stream is copied. Returns the name of the normalized
+// file, or the input unchanged when it has no measurable audio.
+func (f *Ffmpeg) NormalizeLoudness(ctx context.Context, fileName string, target LoudnessTarget) (string, error) {
+	stats, err := f.measureLoudnorm(ctx, fileName, target)
+	if err != nil {
+		return "", fmt.Errorf("Could not measure loudness: %s", err.Error())
+	}
+
+	inputLoudness, err := strconv.ParseFloat(stats.InputI, 64)
+	if err != nil || math.IsInf(inputLoudness, 0) {
+		f.logger.Info("Skipping loudness normalization of silent file", zap.String("file_name", fileName))
+		return fileName, nil
+	}
+
+	extension := filepath.Ext(fileName)
+	normalizedFileName := strings.TrimSuffix(fileName, extension) + "_normalized" + extension
+
+	// loudnorm works at 192kHz internally, so the output is resampled back
+	ffmpegCmd := NewCommand().
+		Input(fileName).
+		VideoCodec("copy").
+		AudioFilter(fmt.Sprintf(
+			"%s:measured_I=%s:measured_TP=%s:measured_LRA=%s:measured_thresh=%s:offset=%s:linear=true,aresample=48000",
+			target.Loudnorm(), stats.InputI, stats.InputTP, stats.InputLRA, stats.InputThresh, stats.TargetOffset,
+		)).
+		AudioCodec("aac").
+		Output(normalizedFileName).
+		OnProgress(f.LogProgress("Normalizing loudness", zap.String("file_name", fileName), zap.String("loudness_target", target.Name)))
+
+	err = f.Run(ctx, ffmpegCmd)
+	if err != nil {
+		os.Remove(normalizedFileName)
+		return "", fmt.Errorf("Could not normalize loudness: %s", err.Error())
+	}
+
+	return normalizedFileName, nil
+}
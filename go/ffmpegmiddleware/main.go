@@ -1,80 +1,110 @@
 package ffmpegmiddleware
 
 import (
-	"bytes"
 	"context"
-	"fmt"
 	"io"
 	"os"
+	"planetcastdev/scheduler"
 	"planetcastdev/utils"
+	"strings"
+	"sync"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
-	"golang.org/x/sync/semaphore"
 )
 
 type Ffmpeg struct {
-	semaphore *semaphore.Weighted
-	logger    *zap.Logger
+	scheduler   *scheduler.Scheduler
+	logger      *zap.Logger
+	filters     map[string]bool
+	filtersOnce sync.Once
 }
 
 type FfmpegConnectProps struct {
-	Logger *zap.Logger
+	Logger    *zap.Logger
+	Scheduler *scheduler.Scheduler
 }
 
 func Connect(args FfmpegConnectProps) *Ffmpeg {
-	maxWorkers := 4
-	sem := semaphore.NewWeighted(int64(maxWorkers))
-	args.Logger.Info("Setting Up Ffmpeg Middleware", zap.Int("max_semaphore_workers", maxWorkers), zap.Any("semaphore", sem))
-	return &Ffmpeg{semaphore: sem, logger: args.Logger}
+	args.Logger.Info("Setting Up Ffmpeg Middleware")
+	return &Ffmpeg{scheduler: args.Scheduler, logger: args.Logger}
 }
 
-func (f *Ffmpeg) Run(ctx context.Context, ffmpegCmd string) (string, error) {
-	if err := f.semaphore.Acquire(ctx, 1); err != nil {
-		return "", fmt.Errorf("Failed to acquire semaphore.")
-	}
-	defer f.semaphore.Release(1)
-	return utils.ExecCommand(ffmpegCmd)
-}
-
-func (f *Ffmpeg) DownscaleFile(ctx context.Context, fileData io.ReadSeeker) (io.ReadSeeker, error) {
+// EncodeFile encodes the video to an H.264 mp4 at the profile's frame size,
+// which is the copy dubbing works on. Files that are already on disk are read
+// in place, anything else is streamed to disk first. The encoded video is
+// returned as a TempFile that the caller must close.
+func (f *Ffmpeg) EncodeFile(ctx context.Context, fileData io.Reader, profile OutputProfile) (*utils.TempFile, error) {
 
 	fileName := uuid.NewString()
 	encodedFileName := fileName + "_encoded.mp4"
 
-	body, err := io.ReadAll(fileData)
-	if err != nil {
-		f.logger.Error("Could not read the file data", zap.Error(err), zap.Any("file_data", fileData))
-		return nil, err
-	}
-
-	os.WriteFile(fileName, body, 0644)
-	fileData.Seek(0, io.SeekStart)
-
-	ffmpegCmd := fmt.Sprintf(`ffmpeg -i file:'%s' -vf 'scale=1280:720:force_original_aspect_ratio=decrease,pad=1280:720:(ow-iw)/2:(oh-ih)/2' -vcodec libx264 -acodec aac -vsync 2 file:'%s'`, fileName, encodedFileName)
-	_, err = f.Run(ctx, ffmpegCmd)
-	if err != nil {
-		f.logger.Error("Could not execute ffmpeg downscaling command", zap.Error(err), zap.String("file_name", fileName))
-		return nil, err
+	if file, ok := fileData.(*os.File); ok {
+		fileName = file.Name()
+	} else {
+		file, err := os.Create(fileName)
+		if err != nil {
+			f.logger.Error("Could not create file for encoding", zap.Error(err), zap.String("file_name", fileName))
+			return nil, err
+		}
+
+		_, err = io.Copy(file, fileData)
+		file.Close()
+		defer utils.DeleteFiles([]string{fileName})
+
+		if err != nil {
+			f.logger.Error("Could not write the file data", zap.Error(err), zap.String("file_name", fileName))
+			return nil, err
+		}
 	}
-	f.logger.Info("Downscaled file to 720p", zap.String("file_name", fileName))
 
-	fileObj, err := os.Open(encodedFileName)
+	ffmpegCmd := NewCommand().
+		Input(fileName).
+		VideoFilter(profile.scaleFilter()).
+		VideoCodec("libx264").
+		Option("-pix_fmt", "yuv420p").
+		AudioCodec("aac").
+		Option("-vsync", "2").
+		Output(encodedFileName).
+		OnProgress(f.LogProgress("Encoding file", zap.String("file_name", fileName), zap.String("output_profile", profile.Name)))
+	err := f.Run(ctx, ffmpegCmd)
 	if err != nil {
-		f.logger.Error("Could not open encoded downscaled file", zap.Error(err), zap.String("file_name", encodedFileName))
+		f.logger.Error("Could not execute ffmpeg encoding command", zap.Error(err), zap.String("file_name", fileName), zap.String("output_profile", profile.Name))
+		utils.DeleteFiles([]string{encodedFileName})
 		return nil, err
 	}
-	defer fileObj.Close()
+	f.logger.Info("Encoded file", zap.String("file_name", fileName), zap.String("output_profile", profile.Name))
 
-	fileContent, err := io.ReadAll(fileObj)
+	encodedFile, err := utils.OpenTempFile(encodedFileName)
 	if err != nil {
-		f.logger.Error("Could not read encoded downscaled file", zap.Error(err), zap.String("file_name", encodedFileName))
+		f.logger.Error("Could not open encoded file", zap.Error(err), zap.String("file_name", encodedFileName))
+		utils.DeleteFiles([]string{encodedFileName})
 		return nil, err
 	}
 
-	readSeeker := bytes.NewReader(fileContent)
-
-	utils.DeleteFiles([]string{fileName, encodedFileName})
+	return encodedFile, nil
+}
 
-	return readSeeker, nil
+// HasFilter reports whether this ffmpeg build includes the filter. Some, like
+// rubberband, are only present when ffmpeg is built with an optional library.
+func (f *Ffmpeg) HasFilter(ctx context.Context, name string) bool {
+	f.filtersOnce.Do(func() {
+		f.filters = map[string]bool{}
+
+		output, err := runCommand(ctx, "ffmpeg", []string{"-hide_banner", "-filters"})
+		if err != nil {
+			f.logger.Error("Could not list ffmpeg filters", zap.Error(err))
+			return
+		}
+
+		// Each filter is listed as its flags, name, inputs and outputs and description
+		for _, line := range strings.Split(output, "\n") {
+			fields := strings.Fields(line)
+			if len(fields) >= 3 && strings.Contains(fields[2], "->") {
+				f.filters[fields[1]] = true
+			}
+		}
+	})
+
+	return f.filters[name]
 }
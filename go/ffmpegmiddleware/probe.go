@@ -0,0 +1,152 @@
+package ffmpegmiddleware
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ProbeCommand builds an ffprobe invocation that prints the requested entries
+// one value per line, or as JSON.
+type ProbeCommand struct {
+	selectStreams string
+	showEntries   string
+	jsonOutput    bool
+	path          string
+	remote        bool
+}
+
+func NewProbe(path string) *ProbeCommand {
+	return &ProbeCommand{path: path}
+}
+
+// NewURLProbe probes a file over http, such as a signed storage URL, so it can
+// be inspected without downloading it first.
+func NewURLProbe(url string) *ProbeCommand {
+	return &ProbeCommand{path: url, remote: true}
+}
+
+func (p *ProbeCommand) SelectStreams(streams string) *ProbeCommand {
+	p.selectStreams = streams
+	return p
+}
+
+func (p *ProbeCommand) ShowEntries(entries string) *ProbeCommand {
+	p.showEntries = entries
+	return p
+}
+
+func (p *ProbeCommand) JSON() *ProbeCommand {
+	p.jsonOutput = true
+	return p
+}
+
+func (p *ProbeCommand) Args() []string {
+	args := []string{"-v", "error"}
+
+	if p.selectStreams != "" {
+		args = append(args, "-select_streams", p.selectStreams)
+	}
+	if p.showEntries != "" {
+		args = append(args, "-show_entries", p.showEntries)
+	}
+
+	if p.jsonOutput {
+		args = append(args, "-of", "json")
+	} else {
+		args = append(args, "-of", "default=noprint_wrappers=1:nokey=1")
+	}
+
+	if p.remote {
+		return append(args, p.path)
+	}
+	return append(args, filePath(p.path))
+}
+
+func (p *ProbeCommand) String() string {
+	return formatCommandLine("ffprobe", p.Args())
+}
+
+// ffprobe only reads headers, anything slower than this is stuck
+const probeTimeout = time.Minute
+
+func Probe(ctx context.Context, probe *ProbeCommand) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+	return runCommand(ctx, "ffprobe", probe.Args())
+}
+
+func GetDuration(ctx context.Context, fileName string) (float64, error) {
+	output, err := Probe(ctx, NewProbe(fileName).ShowEntries("format=duration"))
+	if err != nil {
+		return 0, fmt.Errorf("Could not run ffprobe to get duration: %s", err.Error())
+	}
+
+	text := strings.TrimSpace(output)
+	duration, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return 0, fmt.Errorf("Could not trim space to get duration: %s", err.Error())
+	}
+
+	return duration, nil
+}
+
+func GetFrameRate(ctx context.Context, fileName string) (float64, error) {
+	output, err := Probe(ctx, NewProbe(fileName).SelectStreams("v:0").ShowEntries("stream=r_frame_rate"))
+	if err != nil {
+		return 0, fmt.Errorf("Could not run ffprobe to get frame rate: %s", err.Error())
+	}
+	frameRateString := strings.TrimSpace(output)
+	frameRate, err := parseFrameRateString(frameRateString)
+
+	if err != nil {
+		return 0, fmt.Errorf("Could not get the frame rate: %s, %s", frameRateString, err.Error())
+	}
+
+	return frameRate, nil
+}
+
+// GetCodec returns the codec of the first stream in the file, which is the
+// video stream for videos.
+func GetCodec(ctx context.Context, fileName string) (string, error) {
+	output, err := Probe(ctx, NewProbe(fileName).ShowEntries("stream=codec_name"))
+	if err != nil {
+		return "", fmt.Errorf("Could not run ffprobe to get codec: %s", err.Error())
+	}
+
+	codec := strings.TrimSpace(strings.SplitN(strings.TrimSpace(output), "\n", 2)[0])
+	if codec == "" {
+		return "", fmt.Errorf("No streams found in %s", fileName)
+	}
+
+	return codec, nil
+}
+
+func parseFrameRateString(frameRateString string) (float64, error) {
+	if !strings.Contains(frameRateString, "/") {
+		return strconv.ParseFloat(frameRateString, 64)
+	}
+
+	parts := strings.Split(frameRateString, "/")
+	if len(parts) != 2 {
+		return 0, fmt.Errorf("Unexpected frame rate string: %s", frameRateString)
+	}
+
+	numerator, err := strconv.ParseFloat(parts[0], 64)
+	if err != nil {
+		return 0, fmt.Errorf("Could not parse numerator: %s", err.Error())
+	}
+
+	denominator, err := strconv.ParseFloat(parts[1], 64)
+	if err != nil {
+		return 0, fmt.Errorf("Could not parse denominator: %s", err.Error())
+	}
+
+	if denominator == 0 {
+		return 0, fmt.Errorf("Denominator in frame rate fraction is 0")
+	}
+
+	return numerator / denominator, nil
+}
@@ -0,0 +1,139 @@
+package ffmpegmiddleware
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// OutputProfile describes how a project's video is rendered. Dubbing always
+// works on an H.264/AAC mp4 copy at the profile's frame size, the codecs and
+// container are only applied when the final video is rendered.
+type OutputProfile struct {
+	Name string
+	// Frame size of the output, zero keeps the size of the source
+	Width  int
+	Height int
+
+	VideoCodec string
+	AudioCodec string
+	Options    []string
+	Extension  string
+
+	// Loudness target used unless the project picks its own
+	Loudness string
+}
+
+var outputProfiles = map[string]OutputProfile{
+	"SOURCE": {
+		Name:       "SOURCE",
+		VideoCodec: "libx264",
+		AudioCodec: "aac",
+		Extension:  ".mp4",
+		Loudness:   "YOUTUBE",
+	},
+	"HD_720P": {
+		Name:       "HD_720P",
+		Width:      1280,
+		Height:     720,
+		VideoCodec: "libx264",
+		AudioCodec: "aac",
+		Extension:  ".mp4",
+		Loudness:   "YOUTUBE",
+	},
+	"HD_1080P": {
+		Name:       "HD_1080P",
+		Width:      1920,
+		Height:     1080,
+		VideoCodec: "libx264",
+		AudioCodec: "aac",
+		Extension:  ".mp4",
+		Loudness:   "YOUTUBE",
+	},
+	"UHD_4K": {
+		Name:       "UHD_4K",
+		Width:      3840,
+		Height:     2160,
+		VideoCodec: "libx264",
+		AudioCodec: "aac",
+		Extension:  ".mp4",
+		Loudness:   "YOUTUBE",
+	},
+	"VERTICAL_9_16": {
+		Name:       "VERTICAL_9_16",
+		Width:      1080,
+		Height:     1920,
+		VideoCodec: "libx264",
+		AudioCodec: "aac",
+		Extension:  ".mp4",
+		Loudness:   "YOUTUBE",
+	},
+	"WEBM_VP9": {
+		Name:       "WEBM_VP9",
+		VideoCodec: "libvpx-vp9",
+		AudioCodec: "libopus",
+		Options:    []string{"-crf", "32", "-b:v", "0", "-row-mt", "1"},
+		Extension:  ".webm",
+		Loudness:   "YOUTUBE",
+	},
+	"PRORES_PROXY": {
+		Name:       "PRORES_PROXY",
+		VideoCodec: "prores_ks",
+		AudioCodec: "pcm_s16le",
+		Options:    []string{"-profile:v", "0", "-pix_fmt", "yuv422p10le"},
+		Extension:  ".mov",
+		Loudness:   "BROADCAST",
+	},
+}
+
+func GetOutputProfile(name string) (OutputProfile, error) {
+	profile, ok := outputProfiles[name]
+	if !ok {
+		return OutputProfile{}, fmt.Errorf("Unknown output profile: %s", name)
+	}
+	return profile, nil
+}
+
+// scaleFilter fits the video inside the profile's frame, padding the rest, so
+// nothing is cropped. Without a frame size only odd dimensions are rounded
+// down, which H.264 cannot encode.
+func (p OutputProfile) scaleFilter() string {
+	if p.Width == 0 || p.Height == 0 {
+		return "scale=trunc(iw/2)*2:trunc(ih/2)*2,setsar=1"
+	}
+	return fmt.Sprintf("scale=%d:%d:force_original_aspect_ratio=decrease,pad=%d:%d:(ow-iw)/2:(oh-ih)/2,setsar=1", p.Width, p.Height, p.Width, p.Height)
+}
+
+// needsRender is false when the working copy is already in the delivery format.
+func (p OutputProfile) needsRender() bool {
+	return p.VideoCodec != "libx264" || p.AudioCodec != "aac" || p.Extension != ".mp4" || len(p.Options) > 0
+}
+
+// RenderFile converts a finished H.264 video into the profile's delivery
+// format and returns the name of the rendered file. The input is returned
+// unchanged when it is already in that format.
+func (f *Ffmpeg) RenderFile(ctx context.Context, fileName string, profile OutputProfile) (string, error) {
+	if !profile.needsRender() {
+		return fileName, nil
+	}
+
+	renderedFileName := strings.TrimSuffix(fileName, ".mp4") + "_rendered" + profile.Extension
+
+	ffmpegCmd := NewCommand().
+		Input(fileName).
+		VideoCodec(profile.VideoCodec).
+		AudioCodec(profile.AudioCodec).
+		Option(profile.Options...).
+		Output(renderedFileName).
+		OnProgress(f.LogProgress("Rendering output profile", zap.String("file_name", fileName), zap.String("output_profile", profile.Name)))
+
+	err := f.Run(ctx, ffmpegCmd)
+	if err != nil {
+		f.logger.Error("Could not render output profile", zap.Error(err), zap.String("file_name", fileName), zap.String("output_profile", profile.Name))
+		return "", err
+	}
+
+	return renderedFileName, nil
+}
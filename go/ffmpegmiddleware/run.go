@@ -0,0 +1,165 @@
+package ffmpegmiddleware
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os/exec"
+	"planetcastdev/scheduler"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// Commands get this many seconds to run for every second of output, on
+	// top of the minimum timeout.
+	timeoutPerSecond  = 10
+	minCommandTimeout = 5 * time.Minute
+	// Used when the length of the output cannot be worked out
+	defaultCommandTimeout = 2 * time.Hour
+)
+
+// getExpectedDuration works out how long the output will be, in seconds, so
+// progress can be reported as a fraction. It returns 0 if that is unknown.
+func (c *Command) getExpectedDuration(ctx context.Context) float64 {
+	if c.trimDuration > 0 {
+		return c.trimDuration
+	}
+
+	expected := 0.0
+	for _, input := range c.inputs {
+		duration, err := GetDuration(ctx, input.path)
+		if err != nil {
+			return 0
+		}
+
+		if c.sequential {
+			expected += duration
+		} else {
+			expected = math.Max(expected, duration)
+		}
+	}
+
+	return expected
+}
+
+func getCommandTimeout(expectedDuration float64) time.Duration {
+	if expectedDuration <= 0 {
+		return defaultCommandTimeout
+	}
+	return minCommandTimeout + time.Duration(expectedDuration*timeoutPerSecond)*time.Second
+}
+
+// Run executes the command. It is killed when the context is cancelled or
+// when it runs past a timeout based on the length of its output.
+func (f *Ffmpeg) Run(ctx context.Context, cmd *Command) error {
+	_, err := f.RunWithLog(ctx, cmd)
+	return err
+}
+
+// RunWithLog is Run that also returns what ffmpeg logged.
+func (f *Ffmpeg) RunWithLog(ctx context.Context, cmd *Command) (string, error) {
+	release, err := f.scheduler.Acquire(ctx, scheduler.Ffmpeg)
+	if err != nil {
+		return "", err
+	}
+	defer release()
+
+	expectedDuration := cmd.getExpectedDuration(ctx)
+	timeout := getCommandTimeout(expectedDuration)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	args := cmd.Args()
+	if cmd.onProgress != nil {
+		args = append([]string{"-progress", "pipe:1", "-nostats"}, args...)
+	}
+
+	process := exec.CommandContext(ctx, "ffmpeg", args...)
+
+	var stderr bytes.Buffer
+	process.Stderr = &stderr
+
+	stdout, err := process.StdoutPipe()
+	if err != nil {
+		return "", err
+	}
+
+	err = process.Start()
+	if err != nil {
+		return "", fmt.Errorf("ffmpeg failed to start: %s", err.Error())
+	}
+
+	if cmd.onProgress != nil {
+		readProgress(stdout, expectedDuration, cmd.onProgress)
+	} else {
+		io.Copy(io.Discard, stdout)
+	}
+
+	err = process.Wait()
+
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		f.logger.Error("ffmpeg command timed out", zap.Duration("timeout", timeout), zap.String("ffmpeg_command", cmd.String()))
+		return "", fmt.Errorf("ffmpeg timed out after %s", timeout)
+	}
+	if err != nil {
+		return "", fmt.Errorf("ffmpeg failed: %s, %s", err.Error(), strings.TrimSpace(stderr.String()))
+	}
+
+	return stderr.String(), nil
+}
+
+// readProgress parses the key=value blocks ffmpeg writes for -progress until
+// the process closes its output. Despite its name out_time_ms is in
+// microseconds.
+func readProgress(progressOutput io.Reader, expectedDuration float64, onProgress func(progress float64)) {
+	scanner := bufio.NewScanner(progressOutput)
+
+	for scanner.Scan() {
+		key, value, found := strings.Cut(strings.TrimSpace(scanner.Text()), "=")
+		if found == false {
+			continue
+		}
+
+		switch key {
+		case "out_time_ms":
+			if expectedDuration <= 0 {
+				continue
+			}
+			outTime, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			onProgress(math.Min(math.Max(outTime/1e6/expectedDuration, 0), 1))
+		case "progress":
+			if value == "end" {
+				onProgress(1)
+			}
+		}
+	}
+
+	// Drain whatever is left so ffmpeg never blocks writing to the pipe
+	io.Copy(io.Discard, progressOutput)
+}
+
+// LogProgress returns a progress callback that logs every tenth of the way,
+// so long commands show they are still moving.
+func (f *Ffmpeg) LogProgress(message string, fields ...zap.Field) func(progress float64) {
+	lastStep := -1
+	return func(progress float64) {
+		step := int(progress * 10)
+		if step <= lastStep {
+			return
+		}
+		lastStep = step
+		f.logger.Info(message, append([]zap.Field{zap.Float64("progress", progress)}, fields...)...)
+	}
+}
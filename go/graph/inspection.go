@@ -0,0 +1,87 @@
+package graph
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"os"
+	"planetcastdev/database"
+	"planetcastdev/ffmpegmiddleware"
+	"strconv"
+
+	"go.uber.org/zap"
+)
+
+func getMaxSourceDurationMinutes() int {
+	minutes, err := strconv.Atoi(os.Getenv("MAX_SOURCE_DURATION_MINUTES"))
+	if err != nil || minutes <= 0 {
+		minutes = 90
+	}
+	return minutes
+}
+
+// validateSourceMedia rejects files that cannot be dubbed, before a project
+// is created for them.
+func validateSourceMedia(info *ffmpegmiddleware.MediaInfo) error {
+	err := info.Validate()
+	if err != nil {
+		return err
+	}
+
+	maxMinutes := getMaxSourceDurationMinutes()
+	if info.Duration > float64(maxMinutes*60) {
+		return fmt.Errorf("The video is longer than the %d minute limit", maxMinutes)
+	}
+
+	return nil
+}
+
+// inspectSourceMedia reads and validates a source file on disk.
+func inspectSourceMedia(ctx context.Context, fileName string) (*ffmpegmiddleware.MediaInfo, error) {
+	info, err := ffmpegmiddleware.Inspect(ctx, fileName)
+	if err != nil {
+		return nil, err
+	}
+	return info, validateSourceMedia(info)
+}
+
+func (r *Resolver) saveSourceMediaInfo(ctx context.Context, projectID int64, info *ffmpegmiddleware.MediaInfo) {
+	streams, _ := json.Marshal(info.Streams)
+
+	_, err := r.DB.CreateSourceMediaInfo(ctx, database.CreateSourceMediaInfoParams{
+		ProjectID:       projectID,
+		Container:       info.Container,
+		DurationSeconds: info.Duration,
+		VideoCodec:      info.VideoCodec,
+		Width:           int64(info.Width),
+		Height:          int64(info.Height),
+		FrameRate:       info.FrameRate,
+		AudioCodec:      info.AudioCodec,
+		AudioChannels:   int64(info.AudioChannels),
+		ChannelLayout:   info.ChannelLayout,
+		SampleRate:      int64(info.SampleRate),
+		Streams:         streams,
+	})
+	if err != nil {
+		r.Logger.Error("Could not save source media info", zap.Error(err), zap.Int64("project_id", projectID))
+	}
+}
+
+// measureSourceLoudness is slow as it decodes all of the audio, so it runs
+// once the project has been accepted.
+func (r *Resolver) measureSourceLoudness(ctx context.Context, projectID int64, fileName string) {
+	loudness, err := r.Ffmpeg.MeasureLoudness(ctx, fileName)
+	if err != nil {
+		r.Logger.Error("Could not measure loudness of source media", zap.Error(err), zap.Int64("project_id", projectID))
+		return
+	}
+
+	_, err = r.DB.SetSourceMediaInfoLoudnessByProjectId(ctx, database.SetSourceMediaInfoLoudnessByProjectIdParams{
+		ProjectID:    projectID,
+		LoudnessLufs: sql.NullFloat64{Float64: loudness, Valid: true},
+	})
+	if err != nil {
+		r.Logger.Error("Could not save loudness of source media", zap.Error(err), zap.Int64("project_id", projectID))
+	}
+}
@@ -9,7 +9,9 @@ import (
 	"planetcastdev/dubbing"
 	"planetcastdev/email"
 	"planetcastdev/ffmpegmiddleware"
+	"planetcastdev/mediaassets"
 	"planetcastdev/paymentsmiddleware"
+	"planetcastdev/scheduler"
 	"planetcastdev/storage"
 	"planetcastdev/youtubemiddleware"
 	"strings"
@@ -24,27 +26,31 @@ import (
 )
 
 type GraphConnectProps struct {
-	Queries  *database.Queries
-	Storage  *storage.Storage
-	Dubbing  *dubbing.Dubbing
-	Logger   *zap.Logger
-	Email    *email.Email
-	Youtube  *youtubemiddleware.Youtube
-	Ffmpeg   *ffmpegmiddleware.Ffmpeg
-	Payments *paymentsmiddleware.Payments
+	Queries     *database.Queries
+	Storage     *storage.Storage
+	Dubbing     *dubbing.Dubbing
+	Logger      *zap.Logger
+	Email       *email.Email
+	Youtube     *youtubemiddleware.Youtube
+	Ffmpeg      *ffmpegmiddleware.Ffmpeg
+	Payments    *paymentsmiddleware.Payments
+	MediaAssets *mediaassets.MediaAssets
+	Scheduler   *scheduler.Scheduler
 }
 
 func Connect(args GraphConnectProps) *handler.Server {
 
 	gqlConfig := Config{Resolvers: &Resolver{
-		DB:       args.Queries,
-		Storage:  args.Storage,
-		Dubbing:  args.Dubbing,
-		Logger:   args.Logger,
-		Email:    args.Email,
-		Youtube:  args.Youtube,
-		Ffmpeg:   args.Ffmpeg,
-		Payments: args.Payments,
+		DB:          args.Queries,
+		Storage:     args.Storage,
+		Dubbing:     args.Dubbing,
+		Logger:      args.Logger,
+		Email:       args.Email,
+		Youtube:     args.Youtube,
+		Ffmpeg:      args.Ffmpeg,
+		Payments:    args.Payments,
+		MediaAssets: args.MediaAssets,
+		Scheduler:   args.Scheduler,
 	}}
 
 	logger := args.Logger
@@ -56,6 +62,13 @@ func Connect(args GraphConnectProps) *handler.Server {
 		return next(ctx)
 	}
 
+	gqlConfig.Directives.SuperAdmin = func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error) {
+		if isLoggedIn(ctx) == false || isSuperAdmin(ctx) == false {
+			return nil, fmt.Errorf("Access Denied")
+		}
+		return next(ctx)
+	}
+
 	gqlConfig.Directives.MemberTeam = func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error) {
 		teamSlugField := obj.(map[string]interface{})["teamSlug"]
 		if teamSlugField == nil {
@@ -125,7 +138,7 @@ func Connect(args GraphConnectProps) *handler.Server {
 	gqlServer.AddTransport(transport.Options{})
 	gqlServer.AddTransport(transport.GET{})
 	gqlServer.AddTransport(transport.POST{})
-	gqlServer.AddTransport(transport.MultipartForm{MaxUploadSize: 1024 * MB, MaxMemory: 1024 * MB})
+	gqlServer.AddTransport(transport.MultipartForm{MaxUploadSize: 1024 * MB, MaxMemory: 32 * MB})
 
 	gqlServer.SetQueryCache(lru.New(1000))
 
@@ -19,6 +19,18 @@ type CheckoutSessionResponse struct {
 	SessionID string `json:"sessionId"`
 }
 
+type CompletedUploadPart struct {
+	PartNumber int64  `json:"partNumber"`
+	Etag       string `json:"etag"`
+}
+
+type DubbingCacheStats struct {
+	Kind    database.DubbingCacheKind `json:"kind"`
+	Entries int64                     `json:"entries"`
+	Hits    int64                     `json:"hits"`
+	HitRate float64                   `json:"hitRate"`
+}
+
 type PortalSessionResponse struct {
 	SessionURL string `json:"sessionUrl"`
 }
@@ -33,6 +45,40 @@ type SubscriptionData struct {
 	LastFourCardDigits string `json:"lastFourCardDigits"`
 }
 
+type TranscriptionSettingsInput struct {
+	SourceLanguage *string                      `json:"sourceLanguage,omitempty"`
+	Prompt         *string                      `json:"prompt,omitempty"`
+	Vocabulary     []string                     `json:"vocabulary,omitempty"`
+	Model          *database.TranscriptionModel `json:"model,omitempty"`
+}
+
+type TranslationModelInput struct {
+	Provider     string   `json:"provider"`
+	Model        string   `json:"model"`
+	Temperature  *float64 `json:"temperature,omitempty"`
+	MaxTokens    *int64   `json:"maxTokens,omitempty"`
+	JSONResponse bool     `json:"jsonResponse"`
+}
+
+type TranslationQaReport struct {
+	Segments             []database.TranslationQaSegment `json:"segments"`
+	FlaggedSegments      int64                           `json:"flaggedSegments"`
+	RetranslatedSegments int64                           `json:"retranslatedSegments"`
+	AverageScore         float64                         `json:"averageScore"`
+}
+
+type UploadPart struct {
+	PartNumber int64   `json:"partNumber"`
+	URL        string  `json:"url"`
+	Etag       *string `json:"etag,omitempty"`
+}
+
+type UploadSessionResponse struct {
+	SessionID int64        `json:"sessionId"`
+	PartSize  int64        `json:"partSize"`
+	Parts     []UploadPart `json:"parts"`
+}
+
 type UploadOption string
 
 const (
@@ -0,0 +1,123 @@
+package graph
+
+import (
+	"context"
+	"database/sql"
+	"planetcastdev/database"
+	"planetcastdev/dubbing"
+	"planetcastdev/ffmpegmiddleware"
+	"planetcastdev/graph/model"
+	"planetcastdev/mediaassets"
+	"planetcastdev/utils"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// getOutputProfile returns the profile a new project is rendered with, the
+// team's default unless one was picked for the project.
+func getOutputProfile(team database.Team, outputProfile *database.OutputProfile) database.OutputProfile {
+	if outputProfile != nil {
+		return *outputProfile
+	}
+	return team.DefaultOutputProfile
+}
+
+// getLoudnessTarget returns the loudness a new project is mixed to, the
+// output profile's default unless one was picked for the project.
+func getLoudnessTarget(profile ffmpegmiddleware.OutputProfile, loudnessTarget *database.LoudnessTarget) database.LoudnessTarget {
+	if loudnessTarget != nil {
+		return *loudnessTarget
+	}
+	return database.LoudnessTarget(profile.Loudness)
+}
+
+// getTranscriptionSettings returns the settings a project is transcribed
+// with. Anything not picked is left to Whisper, with its largest model.
+func getTranscriptionSettings(input *model.TranscriptionSettingsInput) database.UpdateProjectTranscriptionSettingsParams {
+	settings := database.UpdateProjectTranscriptionSettingsParams{
+		TranscriptionVocabulary: []string{},
+		TranscriptionModel:      database.TranscriptionModelLARGEV2,
+	}
+	if input == nil {
+		return settings
+	}
+
+	if input.SourceLanguage != nil && strings.TrimSpace(*input.SourceLanguage) != "" {
+		settings.SourceLanguage = sql.NullString{String: strings.ToUpper(strings.TrimSpace(*input.SourceLanguage)), Valid: true}
+	}
+	if input.Prompt != nil && strings.TrimSpace(*input.Prompt) != "" {
+		settings.TranscriptionPrompt = sql.NullString{String: strings.TrimSpace(*input.Prompt), Valid: true}
+	}
+	for _, term := range input.Vocabulary {
+		if term = strings.TrimSpace(term); term != "" {
+			settings.TranscriptionVocabulary = append(settings.TranscriptionVocabulary, term)
+		}
+	}
+	if input.Model != nil {
+		settings.TranscriptionModel = *input.Model
+	}
+
+	return settings
+}
+
+type processProjectSourceProps struct {
+	Project               database.Project
+	File                  *utils.TempFile
+	BaseName              string
+	Gender                string
+	InitialTargetLanguage *string
+	InitialLipSync        bool
+	// Set when the file was inspected before the project was accepted
+	MediaInfo *ffmpegmiddleware.MediaInfo
+}
+
+// processProjectSource stores the project's source video under a unique name
+// and starts transcribing it, followed by the initial translation if one was
+// requested.
+func (r *Resolver) processProjectSource(ctx context.Context, args processProjectSourceProps) {
+	identifier := args.BaseName + uuid.NewString()
+	fileName := identifier + ".mp4"
+
+	mediaInfo := args.MediaInfo
+	if mediaInfo == nil {
+		var err error
+		mediaInfo, err = inspectSourceMedia(ctx, args.File.Name())
+		if err != nil {
+			r.Logger.Error("Source video for project is invalid, deleting project", zap.Error(err), zap.Int64("project_id", args.Project.ID))
+			args.File.Close()
+			r.DB.DeleteProjectById(ctx, args.Project.ID)
+			return
+		}
+	}
+	r.saveSourceMediaInfo(ctx, args.Project.ID, mediaInfo)
+	r.measureSourceLoudness(ctx, args.Project.ID, args.File.Name())
+
+	_, err := r.MediaAssets.Store(ctx, mediaassets.StoreProps{
+		ProjectID: args.Project.ID,
+		Kind:      database.MediaAssetKindSOURCE,
+		Key:       fileName,
+		FilePath:  args.File.Name(),
+	})
+	args.File.Close()
+	if err != nil {
+		r.Logger.Error("Could not store source video for project", zap.Error(err), zap.Int64("project_id", args.Project.ID))
+		return
+	}
+
+	project, _ := r.DB.UpdateProjectSourceMedia(ctx, database.UpdateProjectSourceMediaParams{
+		ID:          args.Project.ID,
+		SourceMedia: fileName,
+	})
+
+	r.Dubbing.CreateTransformation(ctx, dubbing.CreateTransformationParams{
+		ProjectID: project.ID,
+		FileName:  fileName,
+		IsSource:  true,
+	})
+
+	if args.InitialTargetLanguage != nil {
+		r.Mutation().CreateTranslation(ctx, project.ID, *args.InitialTargetLanguage, args.InitialLipSync, args.Gender)
+	}
+}
@@ -5,7 +5,9 @@ import (
 	"planetcastdev/dubbing"
 	"planetcastdev/email"
 	"planetcastdev/ffmpegmiddleware"
+	"planetcastdev/mediaassets"
 	"planetcastdev/paymentsmiddleware"
+	"planetcastdev/scheduler"
 	"planetcastdev/storage"
 	"planetcastdev/youtubemiddleware"
 
@@ -19,12 +21,14 @@ import (
 // It serves as dependency injection for your app, add any dependencies you require here.
 
 type Resolver struct {
-	DB       *database.Queries
-	Storage  *storage.Storage
-	Dubbing  *dubbing.Dubbing
-	Logger   *zap.Logger
-	Email    *email.Email
-	Youtube  *youtubemiddleware.Youtube
-	Ffmpeg   *ffmpegmiddleware.Ffmpeg
-	Payments *paymentsmiddleware.Payments
+	DB          *database.Queries
+	Storage     *storage.Storage
+	Dubbing     *dubbing.Dubbing
+	Logger      *zap.Logger
+	Email       *email.Email
+	Youtube     *youtubemiddleware.Youtube
+	Ffmpeg      *ffmpegmiddleware.Ffmpeg
+	Payments    *paymentsmiddleware.Payments
+	MediaAssets *mediaassets.MediaAssets
+	Scheduler   *scheduler.Scheduler
 }
@@ -0,0 +1,19 @@
+package graph
+
+import (
+	"context"
+	"planetcastdev/scheduler"
+)
+
+// withSchedulerOwner tags background work with the team it is done for, so
+// it waits in line fairly against other teams and at its plan's priority.
+func (r *Resolver) withSchedulerOwner(ctx context.Context, teamID int64) context.Context {
+	owner := scheduler.Owner{TeamID: teamID}
+
+	subscriptions, err := r.DB.GetSubscriptionsByTeamId(ctx, teamID)
+	if err == nil && len(subscriptions) > 0 {
+		owner.Priority = r.Scheduler.PlanPriority(subscriptions[0].PlanTier, subscriptions[0].SubscriptionStatus)
+	}
+
+	return scheduler.WithOwner(ctx, owner)
+}
@@ -9,13 +9,15 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
-	"io"
 	"os"
 	"planetcastdev/auth"
 	"planetcastdev/database"
 	"planetcastdev/dubbing"
+	"planetcastdev/ffmpegmiddleware"
 	"planetcastdev/graph/model"
+	"planetcastdev/paymentsmiddleware"
 	"planetcastdev/utils"
+	"strconv"
 	"strings"
 
 	"github.com/99designs/gqlgen/graphql"
@@ -28,6 +30,43 @@ import (
 	"go.uber.org/zap"
 )
 
+// TransformationID is the resolver for the transformationId field.
+func (r *mediaAssetResolver) TransformationID(ctx context.Context, obj *database.MediaAsset) (*int64, error) {
+	if obj.TransformationID.Valid == false {
+		return nil, nil
+	}
+	transformationId := obj.TransformationID.Int64
+	return &transformationId, nil
+}
+
+// URL is the resolver for the url field.
+func (r *mediaAssetResolver) URL(ctx context.Context, obj *database.MediaAsset) (string, error) {
+	return r.Storage.GetFileLink(obj.StorageKey), nil
+}
+
+// DurationSeconds is the resolver for the durationSeconds field.
+func (r *mediaAssetResolver) DurationSeconds(ctx context.Context, obj *database.MediaAsset) (*float64, error) {
+	if obj.DurationSeconds.Valid == false {
+		return nil, nil
+	}
+	durationSeconds := obj.DurationSeconds.Float64
+	return &durationSeconds, nil
+}
+
+// Codec is the resolver for the codec field.
+func (r *mediaAssetResolver) Codec(ctx context.Context, obj *database.MediaAsset) (*string, error) {
+	if obj.Codec.Valid == false {
+		return nil, nil
+	}
+	codec := obj.Codec.String
+	return &codec, nil
+}
+
+// Created is the resolver for the created field.
+func (r *mediaAssetResolver) Created(ctx context.Context, obj *database.MediaAsset) (string, error) {
+	return obj.Created.String(), nil
+}
+
 // CreateTeam is the resolver for the createTeam field.
 func (r *mutationResolver) CreateTeam(ctx context.Context, teamType database.TeamType, addTrial bool) (database.Team, error) {
 	email, _ := auth.EmailFromContext(ctx)
@@ -92,39 +131,68 @@ func (r *mutationResolver) CreateTeam(ctx context.Context, teamType database.Tea
 }
 
 // CreateProject is the resolver for the createProject field.
-func (r *mutationResolver) CreateProject(ctx context.Context, teamSlug string, title string, sourceMedia *graphql.Upload, youtubeLink *string, uploadOption model.UploadOption, gender string, initialTargetLanguage *string, initialLipSync bool) (database.Project, error) {
+func (r *mutationResolver) CreateProject(ctx context.Context, teamSlug string, title string, sourceMedia *graphql.Upload, youtubeLink *string, uploadOption model.UploadOption, gender string, initialTargetLanguage *string, initialLipSync bool, outputProfile *database.OutputProfile, loudnessTarget *database.LoudnessTarget, transcription *model.TranscriptionSettingsInput) (database.Project, error) {
 	team, _ := r.DB.GetTeamBySlug(ctx, teamSlug)
 
 	// check if file upload or youtube
 	// if youtube link, validate the link, if valid, start download
 
-	var file io.ReadSeeker
-	var identifier string
+	var file *utils.TempFile
 	var fileName string
 
+	projectOutputProfile := getOutputProfile(team, outputProfile)
+	profile, err := ffmpegmiddleware.GetOutputProfile(string(projectOutputProfile))
+	if err != nil {
+		return database.Project{}, err
+	}
+
+	var sourceFile *utils.TempFile
+	var mediaInfo *ffmpegmiddleware.MediaInfo
+
 	if uploadOption == model.UploadOptionYoutubeLink {
 		_, err := r.Youtube.GetVideoInfo(*youtubeLink)
 		if err != nil {
 			return database.Project{}, fmt.Errorf("Error processing YouTube video: %s", err.Error())
 		}
+	} else {
+		if sourceMedia == nil {
+			return database.Project{}, fmt.Errorf("No source media uploaded")
+		}
+
+		sourceFile, err = utils.CreateTempFile(uuid.NewString()+"_upload", sourceMedia.File)
+		if err != nil {
+			return database.Project{}, fmt.Errorf("Could not save uploaded video: %s", err.Error())
+		}
+
+		mediaInfo, err = inspectSourceMedia(ctx, sourceFile.Name())
+		if err != nil {
+			sourceFile.Close()
+			return database.Project{}, fmt.Errorf("Invalid video: %s", err.Error())
+		}
 	}
 
+	transcriptionSettings := getTranscriptionSettings(transcription)
 	project, _ := r.DB.CreateProject(ctx, database.CreateProjectParams{
-		TeamID:      team.ID,
-		Title:       title,
-		SourceMedia: "",
+		TeamID:                  team.ID,
+		Title:                   title,
+		SourceMedia:             "",
+		OutputProfile:           projectOutputProfile,
+		LoudnessTarget:          getLoudnessTarget(profile, loudnessTarget),
+		SourceLanguage:          transcriptionSettings.SourceLanguage,
+		TranscriptionPrompt:     transcriptionSettings.TranscriptionPrompt,
+		TranscriptionVocabulary: transcriptionSettings.TranscriptionVocabulary,
+		TranscriptionModel:      transcriptionSettings.TranscriptionModel,
 	})
 
 	user := auth.FromContext(ctx)
 	newCtx := context.Background()
 	newCtx = auth.AttachContext(newCtx, user)
+	newCtx = r.withSchedulerOwner(newCtx, team.ID)
 
 	go func(context context.Context) {
 
-		randomString := uuid.NewString()
-
 		if uploadOption == model.UploadOptionYoutubeLink {
-			youtubeFile, youtubeFileName, err := r.Youtube.Download(*youtubeLink)
+			youtubeFile, youtubeFileName, err := r.Youtube.Download(*youtubeLink, profile)
 
 			if err != nil {
 				r.Logger.Info("Could not download youtube video for project", zap.Error(err), zap.Int64("project_id", project.ID), zap.String("youtube_url", *youtubeLink))
@@ -134,32 +202,166 @@ func (r *mutationResolver) CreateProject(ctx context.Context, teamSlug string, t
 			file = youtubeFile
 			fileName = strings.ReplaceAll(youtubeFileName, " ", "_")
 		} else {
-			file, _ = r.Ffmpeg.DownscaleFile(context, sourceMedia.File)
+			encodedFile, err := r.Ffmpeg.EncodeFile(context, sourceFile.File, profile)
+			sourceFile.Close()
+
+			if err != nil {
+				r.Logger.Error("Could not encode uploaded video for project", zap.Error(err), zap.Int64("project_id", project.ID))
+				return
+			}
+
+			file = encodedFile
 			fileName = strings.Split(sourceMedia.Filename, ".mp4")[0]
 			fileName = strings.ReplaceAll(fileName, " ", "_")
 		}
 
-		identifier = fileName + randomString
-		fileName = identifier + ".mp4"
+		r.processProjectSource(context, processProjectSourceProps{
+			Project:               project,
+			File:                  file,
+			BaseName:              fileName,
+			Gender:                gender,
+			InitialTargetLanguage: initialTargetLanguage,
+			InitialLipSync:        initialLipSync,
+			MediaInfo:             mediaInfo,
+		})
 
-		r.Storage.Upload(fileName, file)
+	}(newCtx)
 
-		project, _ = r.DB.UpdateProjectSourceMedia(context, database.UpdateProjectSourceMediaParams{
-			ID:          project.ID,
-			SourceMedia: fileName,
-		})
+	return project, nil
+}
 
-		r.Dubbing.CreateTransformation(context, dubbing.CreateTransformationParams{
-			ProjectID: project.ID,
-			FileName:  fileName,
-			IsSource:  true,
-		})
+// CreateUploadSession is the resolver for the createUploadSession field.
+func (r *mutationResolver) CreateUploadSession(ctx context.Context, teamSlug string, fileName string, fileSize int64) (model.UploadSessionResponse, error) {
+	if r.Storage.Multipart == nil {
+		return model.UploadSessionResponse{}, fmt.Errorf("Resumable uploads are not supported by the storage backend")
+	}
 
-		if initialTargetLanguage != nil {
-			r.CreateTranslation(context, project.ID, *initialTargetLanguage, initialLipSync, gender)
-		}
+	if fileSize <= 0 || fileSize > maxUploadSize {
+		return model.UploadSessionResponse{}, fmt.Errorf("File size must be between 1 byte and %d bytes", maxUploadSize)
+	}
 
-	}(newCtx)
+	team, err := r.DB.GetTeamBySlug(ctx, teamSlug)
+	if err != nil {
+		return model.UploadSessionResponse{}, fmt.Errorf("Could not find team %s: %s", teamSlug, err.Error())
+	}
+
+	uploadKey := getUploadKey(fileName)
+
+	storageUploadId, err := r.Storage.Multipart.CreateMultipartUpload(ctx, uploadKey)
+	if err != nil {
+		return model.UploadSessionResponse{}, err
+	}
+
+	uploadSession, err := r.DB.CreateUploadSession(ctx, database.CreateUploadSessionParams{
+		TeamID:          team.ID,
+		UploadKey:       uploadKey,
+		StorageUploadID: storageUploadId,
+		FileName:        fileName,
+		FileSize:        fileSize,
+		PartSize:        getUploadPartSize(fileSize),
+	})
+	if err != nil {
+		r.Storage.Multipart.AbortMultipartUpload(ctx, uploadKey, storageUploadId)
+		return model.UploadSessionResponse{}, fmt.Errorf("Could not create upload session: %s", err.Error())
+	}
+
+	return r.getUploadSessionResponse(ctx, uploadSession)
+}
+
+// ResumeUploadSession is the resolver for the resumeUploadSession field.
+func (r *mutationResolver) ResumeUploadSession(ctx context.Context, teamSlug string, sessionID int64) (model.UploadSessionResponse, error) {
+	if r.Storage.Multipart == nil {
+		return model.UploadSessionResponse{}, fmt.Errorf("Resumable uploads are not supported by the storage backend")
+	}
+
+	team, _ := r.DB.GetTeamBySlug(ctx, teamSlug)
+
+	uploadSession, err := r.DB.GetUploadSessionByIdTeamId(ctx, database.GetUploadSessionByIdTeamIdParams{
+		ID:     sessionID,
+		TeamID: team.ID,
+	})
+	if err != nil {
+		return model.UploadSessionResponse{}, fmt.Errorf("Could not find upload session %d", sessionID)
+	}
+
+	if uploadSession.Status != database.UploadStatusPENDING {
+		return model.UploadSessionResponse{}, fmt.Errorf("Upload session %d is no longer active", sessionID)
+	}
+
+	return r.getUploadSessionResponse(ctx, uploadSession)
+}
+
+// CompleteUpload is the resolver for the completeUpload field.
+func (r *mutationResolver) CompleteUpload(ctx context.Context, teamSlug string, sessionID int64, parts []model.CompletedUploadPart, title string, gender string, initialTargetLanguage *string, initialLipSync bool, outputProfile *database.OutputProfile, loudnessTarget *database.LoudnessTarget, transcription *model.TranscriptionSettingsInput) (database.Project, error) {
+	if r.Storage.Multipart == nil {
+		return database.Project{}, fmt.Errorf("Resumable uploads are not supported by the storage backend")
+	}
+
+	team, _ := r.DB.GetTeamBySlug(ctx, teamSlug)
+
+	uploadSession, err := r.DB.GetUploadSessionByIdTeamId(ctx, database.GetUploadSessionByIdTeamIdParams{
+		ID:     sessionID,
+		TeamID: team.ID,
+	})
+	if err != nil {
+		return database.Project{}, fmt.Errorf("Could not find upload session %d", sessionID)
+	}
+
+	if uploadSession.Status != database.UploadStatusPENDING {
+		return database.Project{}, fmt.Errorf("Upload session %d is no longer active", sessionID)
+	}
+
+	err = r.completeUploadSession(ctx, uploadSession, parts)
+	if err != nil {
+		return database.Project{}, fmt.Errorf("Could not complete upload: %s", err.Error())
+	}
+
+	mediaInfo, err := r.inspectUpload(ctx, uploadSession)
+	if err != nil {
+		return database.Project{}, fmt.Errorf("Invalid video: %s", err.Error())
+	}
+
+	projectOutputProfile := getOutputProfile(team, outputProfile)
+	profile, err := ffmpegmiddleware.GetOutputProfile(string(projectOutputProfile))
+	if err != nil {
+		return database.Project{}, err
+	}
+
+	// Only the request that moves the session out of PENDING creates the project
+	uploadSession, err = r.DB.CompleteUploadSessionById(ctx, uploadSession.ID)
+	if err != nil {
+		return database.Project{}, fmt.Errorf("Upload session %d is no longer active", sessionID)
+	}
+
+	transcriptionSettings := getTranscriptionSettings(transcription)
+	project, err := r.DB.CreateProject(ctx, database.CreateProjectParams{
+		TeamID:                  team.ID,
+		Title:                   title,
+		SourceMedia:             "",
+		OutputProfile:           projectOutputProfile,
+		LoudnessTarget:          getLoudnessTarget(profile, loudnessTarget),
+		SourceLanguage:          transcriptionSettings.SourceLanguage,
+		TranscriptionPrompt:     transcriptionSettings.TranscriptionPrompt,
+		TranscriptionVocabulary: transcriptionSettings.TranscriptionVocabulary,
+		TranscriptionModel:      transcriptionSettings.TranscriptionModel,
+	})
+	if err != nil {
+		return database.Project{}, fmt.Errorf("Could not create project: %s", err.Error())
+	}
+
+	user := auth.FromContext(ctx)
+	newCtx := context.Background()
+	newCtx = auth.AttachContext(newCtx, user)
+	newCtx = r.withSchedulerOwner(newCtx, team.ID)
+
+	go r.processUploadedProject(newCtx, processUploadedProjectProps{
+		Project:               project,
+		UploadSession:         uploadSession,
+		Gender:                gender,
+		InitialTargetLanguage: initialTargetLanguage,
+		InitialLipSync:        initialLipSync,
+		MediaInfo:             mediaInfo,
+	})
 
 	return project, nil
 }
@@ -167,17 +369,55 @@ func (r *mutationResolver) CreateProject(ctx context.Context, teamSlug string, t
 // DeleteProject is the resolver for the deleteProject field.
 func (r *mutationResolver) DeleteProject(ctx context.Context, projectID int64) (database.Project, error) {
 	transformations, _ := r.DB.GetTransformationsByProjectId(ctx, projectID)
+	for _, tfn := range transformations {
+		err := r.Payments.ReleaseCreditsByTransformationId(ctx, tfn.ID)
+		if err != nil {
+			r.Logger.Error("Failed to release credit reservation for deleted project", zap.Error(err), zap.Int64("transformation_id", tfn.ID))
+		}
+	}
+	// The asset rows go with the project, so collect them first
+	assets, _ := r.DB.GetMediaAssetsByProjectId(ctx, projectID)
 	project, _ := r.DB.DeleteProjectById(ctx, projectID)
 
+	go r.MediaAssets.DeleteObjects(assets)
+
+	return project, nil
+}
+
+// RetranscribeProject is the resolver for the retranscribeProject field.
+func (r *mutationResolver) RetranscribeProject(ctx context.Context, projectID int64, transcription model.TranscriptionSettingsInput) (database.Project, error) {
+	sourceTransformation, err := r.DB.GetSourceTransformationByProjectId(ctx, projectID)
+	if err != nil {
+		return database.Project{}, fmt.Errorf("Project has not been transcribed yet")
+	}
+
+	// Claimed here rather than in the job, so a second request made before
+	// the job starts is turned away
+	sourceTransformation, err = r.DB.StartTransformationProcessingById(ctx, sourceTransformation.ID)
+	if err == sql.ErrNoRows {
+		return database.Project{}, fmt.Errorf("Project is already being transcribed")
+	}
+	if err != nil {
+		return database.Project{}, fmt.Errorf("Could not start transcription: %s", err.Error())
+	}
+
+	settings := getTranscriptionSettings(&transcription)
+	settings.ID = projectID
+	project, err := r.DB.UpdateProjectTranscriptionSettings(ctx, settings)
+	if err != nil {
+		r.DB.UpdateTransformationStatusById(ctx, database.UpdateTransformationStatusByIdParams{
+			ID:     sourceTransformation.ID,
+			Status: "complete",
+		})
+		return database.Project{}, fmt.Errorf("Could not update transcription settings: %s", err.Error())
+	}
+
+	user := auth.FromContext(ctx)
 	newCtx := context.Background()
-	go func(ctx context.Context) {
-		for _, tfn := range transformations {
-			r.Storage.DeleteFile(tfn.TargetMedia)
-			if tfn.IsSource == true {
-				r.Storage.DeleteFile(fmt.Sprintf("%s-demucs.mp3", tfn.TargetMedia))
-			}
-		}
-	}(newCtx)
+	newCtx = auth.AttachContext(newCtx, user)
+	newCtx = r.withSchedulerOwner(newCtx, project.TeamID)
+
+	go r.Dubbing.Retranscribe(newCtx, sourceTransformation)
 
 	return project, nil
 }
@@ -203,28 +443,18 @@ func (r *mutationResolver) CreateTranslation(ctx context.Context, projectID int6
 	json.Unmarshal(sourceTransformation.Transcript.RawMessage, &whisperOutput)
 	requiredCredits := r.Dubbing.GetTranscriptLength(&whisperOutput)
 
-	//Check for existing credits, if not enough return
 	project, _ := r.DB.GetProjectById(ctx, projectID)
-	subs, _ := r.DB.GetSubscriptionsByTeamId(ctx, project.TeamID)
-	subPlan := subs[0]
 
-	currentCredits := subPlan.RemainingCredits
-	if int64(requiredCredits) > currentCredits {
-		return database.Transformation{}, fmt.Errorf("No sufficient credits available to process dubbing. Remaining: %d. Required: %d.", currentCredits, requiredCredits)
+	profile, err := ffmpegmiddleware.GetOutputProfile(string(project.OutputProfile))
+	if err != nil {
+		return database.Transformation{}, err
 	}
 
-	//if enough credits, deduct
-	remainingCredits := currentCredits - int64(requiredCredits)
-	subPlan, _ = r.DB.SetRemainingCreditsById(ctx, database.SetRemainingCreditsByIdParams{
-		RemainingCredits: remainingCredits,
-		ID:               subPlan.ID,
-	})
-
 	identifier := fmt.Sprintf("%d-%s-%s", sourceTransformation.ProjectID, utils.GetCurrentDateTimeString(), targetLanguage)
-	newFileName := identifier + "_dubbed.mp4"
+	newFileName := identifier + "_dubbed" + profile.Extension
 
 	// create empty transformation in target language, if target transformation already exists, return that
-	newTransformation, _ := r.DB.CreateTransformation(ctx, database.CreateTransformationParams{
+	newTransformation, err := r.DB.CreateTransformation(ctx, database.CreateTransformationParams{
 		ProjectID:      projectID,
 		TargetLanguage: targetLanguage,
 		TargetMedia:    newFileName,
@@ -233,13 +463,28 @@ func (r *mutationResolver) CreateTranslation(ctx context.Context, projectID int6
 		Status:         "starting",
 		Progress:       0,
 	})
+	if err != nil {
+		return database.Transformation{}, fmt.Errorf("Could not create transformation: %s", err.Error())
+	}
+
+	// Hold the estimated credits, the final charge is settled once dubbing finishes
+	reservation, err := r.Payments.ReserveCredits(ctx, paymentsmiddleware.ReserveCreditsProps{
+		TeamID:           project.TeamID,
+		TransformationID: newTransformation.ID,
+		Credits:          int64(requiredCredits),
+	})
+	if err != nil {
+		r.DB.DeleteTransformationById(ctx, newTransformation.ID)
+		return database.Transformation{}, err
+	}
 
 	user := auth.FromContext(ctx)
 	newCtx := context.Background()
 	newCtx = auth.AttachContext(newCtx, user)
+	newCtx = r.withSchedulerOwner(newCtx, project.TeamID)
 
 	go func(context context.Context) {
-		_, err := r.Dubbing.CreateTranslation(
+		transformation, err := r.Dubbing.CreateTranslation(
 			newCtx,
 			dubbing.CreateTranslationProps{
 				SourceTransformation: sourceTransformation,
@@ -256,11 +501,21 @@ func (r *mutationResolver) CreateTranslation(ctx context.Context, projectID int6
 				ID:     newTransformation.ID,
 				Status: "error",
 			})
-			//Reimburse credit
-			r.DB.SetRemainingCreditsById(newCtx, database.SetRemainingCreditsByIdParams{
-				ID:               subPlan.ID,
-				RemainingCredits: currentCredits,
-			})
+			err = r.Payments.ReleaseCredits(newCtx, reservation.ID)
+			if err != nil {
+				r.Logger.Error("Failed to release credit reservation", zap.Error(err), zap.Int64("reservation_id", reservation.ID))
+			}
+			return
+		}
+
+		// Charge for the speech that was actually dubbed
+		var translatedOutput dubbing.WhisperOutput
+		json.Unmarshal(transformation.Transcript.RawMessage, &translatedOutput)
+		usedCredits := r.Dubbing.GetTranscriptLength(&translatedOutput)
+
+		err = r.Payments.CaptureCredits(newCtx, reservation.ID, int64(usedCredits))
+		if err != nil {
+			r.Logger.Error("Failed to capture credit reservation", zap.Error(err), zap.Int64("reservation_id", reservation.ID))
 		}
 
 	}(newCtx)
@@ -270,12 +525,15 @@ func (r *mutationResolver) CreateTranslation(ctx context.Context, projectID int6
 
 // DeleteTransformation is the resolver for the deleteTransformation field.
 func (r *mutationResolver) DeleteTransformation(ctx context.Context, transformationID int64) (database.Transformation, error) {
+	err := r.Payments.ReleaseCreditsByTransformationId(ctx, transformationID)
+	if err != nil {
+		r.Logger.Error("Failed to release credit reservation for deleted transformation", zap.Error(err), zap.Int64("transformation_id", transformationID))
+	}
+
+	assets, _ := r.DB.GetMediaAssetsByTransformationId(ctx, sql.NullInt64{Int64: transformationID, Valid: true})
 	transformation, _ := r.DB.DeleteTransformationById(ctx, transformationID)
 
-	newCtx := context.Background()
-	go func(ctx context.Context) {
-		r.Storage.DeleteFile(transformation.TargetMedia)
-	}(newCtx)
+	go r.MediaAssets.DeleteObjects(assets)
 
 	return transformation, nil
 }
@@ -315,6 +573,9 @@ func (r *mutationResolver) CreateCheckoutSession(ctx context.Context, teamSlug s
 	})
 
 	currentCustomer, err := r.Payments.GetCustomerByTeamSlug(ctx, teamSlug)
+	if err != nil {
+		return model.CheckoutSessionResponse{}, err
+	}
 
 	params := &stripe.CheckoutSessionParams{
 		Mode:               stripe.String(string(stripe.CheckoutSessionModeSubscription)),
@@ -325,6 +586,17 @@ func (r *mutationResolver) CreateCheckoutSession(ctx context.Context, teamSlug s
 		Customer:           stripe.String(currentCustomer.ID),
 	}
 
+	// One-off credit packs are paid once instead of starting a subscription
+	if paymentsmiddleware.IsCreditPack(price) {
+		credits, err := paymentsmiddleware.GetCreditPackCredits(price)
+		if err != nil {
+			return model.CheckoutSessionResponse{}, err
+		}
+		params.Mode = stripe.String(string(stripe.CheckoutSessionModePayment))
+		params.AddMetadata("credits", strconv.FormatInt(credits, 10))
+		params.AddMetadata("team_slug", teamSlug)
+	}
+
 	session, err := session.New(params)
 
 	if err != nil {
@@ -363,6 +635,54 @@ func (r *mutationResolver) CreatePortalSession(ctx context.Context, teamSlug str
 	return model.PortalSessionResponse{SessionURL: ps.URL}, nil
 }
 
+// SetOverageBilling is the resolver for the setOverageBilling field.
+func (r *mutationResolver) SetOverageBilling(ctx context.Context, teamSlug string, enabled bool, spendCapUsd int64) (database.SubscriptionPlan, error) {
+	return r.Payments.SetOverageBilling(ctx, paymentsmiddleware.SetOverageBillingProps{
+		TeamSlug:    teamSlug,
+		Enabled:     enabled,
+		SpendCapUsd: spendCapUsd,
+	})
+}
+
+// SetDubbingCache is the resolver for the setDubbingCache field.
+func (r *mutationResolver) SetDubbingCache(ctx context.Context, teamSlug string, enabled bool) (database.Team, error) {
+	team, err := r.DB.GetTeamBySlug(ctx, teamSlug)
+	if err != nil {
+		return database.Team{}, fmt.Errorf("Team not found")
+	}
+
+	return r.DB.SetTeamDubbingCacheEnabledById(ctx, database.SetTeamDubbingCacheEnabledByIdParams{
+		ID:                  team.ID,
+		DubbingCacheEnabled: enabled,
+	})
+}
+
+// SetDefaultOutputProfile is the resolver for the setDefaultOutputProfile field.
+func (r *mutationResolver) SetDefaultOutputProfile(ctx context.Context, teamSlug string, outputProfile database.OutputProfile) (database.Team, error) {
+	team, err := r.DB.GetTeamBySlug(ctx, teamSlug)
+	if err != nil {
+		return database.Team{}, fmt.Errorf("Team not found")
+	}
+
+	return r.DB.SetTeamDefaultOutputProfileById(ctx, database.SetTeamDefaultOutputProfileByIdParams{
+		ID:                   team.ID,
+		DefaultOutputProfile: outputProfile,
+	})
+}
+
+// SetTranslationQaMode is the resolver for the setTranslationQaMode field.
+func (r *mutationResolver) SetTranslationQaMode(ctx context.Context, teamSlug string, mode database.TranslationQaMode) (database.Team, error) {
+	team, err := r.DB.GetTeamBySlug(ctx, teamSlug)
+	if err != nil {
+		return database.Team{}, fmt.Errorf("Team not found")
+	}
+
+	return r.DB.SetTeamTranslationQaModeById(ctx, database.SetTeamTranslationQaModeByIdParams{
+		ID:                team.ID,
+		TranslationQaMode: mode,
+	})
+}
+
 // SendTeamInvite is the resolver for the sendTeamInvite field.
 func (r *mutationResolver) SendTeamInvite(ctx context.Context, teamSlug string, inviteeEmail string) (bool, error) {
 	team, _ := r.DB.GetTeamBySlug(ctx, teamSlug)
@@ -416,6 +736,120 @@ func (r *mutationResolver) AcceptTeamInvite(ctx context.Context, inviteSlug stri
 	return true, nil
 }
 
+// ReplayStripeEvent is the resolver for the replayStripeEvent field.
+func (r *mutationResolver) ReplayStripeEvent(ctx context.Context, stripeEventID string) (database.StripeEvent, error) {
+	return r.Payments.ReplayStripeEvent(ctx, stripeEventID)
+}
+
+// SetRetentionPolicy is the resolver for the setRetentionPolicy field.
+func (r *mutationResolver) SetRetentionPolicy(ctx context.Context, teamSlug string, kind database.MediaAssetKind, retentionDays int64) (database.RetentionPolicy, error) {
+	if retentionDays < 0 {
+		return database.RetentionPolicy{}, fmt.Errorf("Retention days cannot be negative")
+	}
+
+	team, err := r.DB.GetTeamBySlug(ctx, teamSlug)
+	if err != nil {
+		return database.RetentionPolicy{}, fmt.Errorf("Team not found")
+	}
+
+	return r.DB.SetRetentionPolicy(ctx, database.SetRetentionPolicyParams{
+		TeamID:        team.ID,
+		Kind:          kind,
+		RetentionDays: retentionDays,
+	})
+}
+
+// SetTranslationModels is the resolver for the setTranslationModels field.
+func (r *mutationResolver) SetTranslationModels(ctx context.Context, teamSlug string, sourceLanguage *string, targetLanguage *string, models []model.TranslationModelInput) ([]database.TranslationModel, error) {
+	team, err := r.DB.GetTeamBySlug(ctx, teamSlug)
+	if err != nil {
+		return nil, fmt.Errorf("Team not found")
+	}
+
+	for _, translationModel := range models {
+		if !r.Dubbing.HasTranslationProvider(translationModel.Provider) {
+			return nil, fmt.Errorf("Unknown translation provider: %s", translationModel.Provider)
+		}
+		if translationModel.Model == "" {
+			return nil, fmt.Errorf("Translation model cannot be empty")
+		}
+	}
+
+	// An empty language applies to every language
+	languages := database.DeleteTranslationModelsByTeamIdLanguagesParams{TeamID: team.ID}
+	if sourceLanguage != nil {
+		languages.SourceLanguage = *sourceLanguage
+	}
+	if targetLanguage != nil {
+		languages.TargetLanguage = *targetLanguage
+	}
+
+	translationModels := []database.TranslationModel{}
+	err = r.DB.ExecTx(ctx, func(q *database.Queries) error {
+		err := q.DeleteTranslationModelsByTeamIdLanguages(ctx, languages)
+		if err != nil {
+			return err
+		}
+
+		for position, translationModel := range models {
+			params := database.CreateTranslationModelParams{
+				TeamID:         team.ID,
+				SourceLanguage: languages.SourceLanguage,
+				TargetLanguage: languages.TargetLanguage,
+				Position:       int64(position),
+				Provider:       translationModel.Provider,
+				Model:          translationModel.Model,
+				JsonResponse:   translationModel.JSONResponse,
+			}
+			if translationModel.Temperature != nil {
+				params.Temperature = sql.NullFloat64{Float64: *translationModel.Temperature, Valid: true}
+			}
+			if translationModel.MaxTokens != nil {
+				params.MaxTokens = sql.NullInt64{Int64: *translationModel.MaxTokens, Valid: true}
+			}
+
+			created, err := q.CreateTranslationModel(ctx, params)
+			if err != nil {
+				return err
+			}
+			translationModels = append(translationModels, created)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Could not save translation models: %s", err.Error())
+	}
+
+	return translationModels, nil
+}
+
+// SourceLanguage is the resolver for the sourceLanguage field.
+func (r *projectResolver) SourceLanguage(ctx context.Context, obj *database.Project) (*string, error) {
+	if obj.SourceLanguage.Valid == false {
+		return nil, nil
+	}
+	sourceLanguage := obj.SourceLanguage.String
+	return &sourceLanguage, nil
+}
+
+// TranscriptionPrompt is the resolver for the transcriptionPrompt field.
+func (r *projectResolver) TranscriptionPrompt(ctx context.Context, obj *database.Project) (*string, error) {
+	if obj.TranscriptionPrompt.Valid == false {
+		return nil, nil
+	}
+	transcriptionPrompt := obj.TranscriptionPrompt.String
+	return &transcriptionPrompt, nil
+}
+
+// MediaInfo is the resolver for the mediaInfo field.
+func (r *projectResolver) MediaInfo(ctx context.Context, obj *database.Project) (*database.SourceMediaInfo, error) {
+	mediaInfo, err := r.DB.GetSourceMediaInfoByProjectId(ctx, obj.ID)
+	if err != nil {
+		return nil, nil
+	}
+	return &mediaInfo, nil
+}
+
 // DubbingCreditsRequired is the resolver for the dubbingCreditsRequired field.
 func (r *projectResolver) DubbingCreditsRequired(ctx context.Context, obj *database.Project) (*int64, error) {
 	sourceTransformation, err := r.DB.GetSourceTransformationByProjectId(ctx, obj.ID)
@@ -456,6 +890,11 @@ func (r *projectResolver) Transformations(ctx context.Context, obj *database.Pro
 	return filteredTransformation, nil
 }
 
+// Assets is the resolver for the assets field.
+func (r *projectResolver) Assets(ctx context.Context, obj *database.Project) ([]database.MediaAsset, error) {
+	return r.DB.GetMediaAssetsByProjectId(ctx, obj.ID)
+}
+
 // GetTeams is the resolver for the getTeams field.
 func (r *queryResolver) GetTeams(ctx context.Context) ([]database.Team, error) {
 	teams := []database.Team{}
@@ -488,6 +927,68 @@ func (r *queryResolver) GetUserInfo(ctx context.Context) (model.AccountInfo, err
 	return model.AccountInfo{User: user, Invites: invites, Teams: memberships}, nil
 }
 
+// GetFailedStripeEvents is the resolver for the getFailedStripeEvents field.
+func (r *queryResolver) GetFailedStripeEvents(ctx context.Context) ([]database.StripeEvent, error) {
+	return r.Payments.GetFailedStripeEvents(ctx)
+}
+
+// GetDubbingCacheStats is the resolver for the getDubbingCacheStats field.
+func (r *queryResolver) GetDubbingCacheStats(ctx context.Context) ([]model.DubbingCacheStats, error) {
+	cacheStats, err := r.Dubbing.GetCacheStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := []model.DubbingCacheStats{}
+	for _, s := range cacheStats {
+		stats = append(stats, model.DubbingCacheStats{Kind: s.Kind, Entries: s.Entries, Hits: s.Hits, HitRate: s.HitRate})
+	}
+
+	return stats, nil
+}
+
+// Created is the resolver for the created field.
+func (r *retentionPolicyResolver) Created(ctx context.Context, obj *database.RetentionPolicy) (string, error) {
+	return obj.Created.String(), nil
+}
+
+// LoudnessLufs is the resolver for the loudnessLufs field.
+func (r *sourceMediaInfoResolver) LoudnessLufs(ctx context.Context, obj *database.SourceMediaInfo) (*float64, error) {
+	if obj.LoudnessLufs.Valid == false {
+		return nil, nil
+	}
+	loudnessLufs := obj.LoudnessLufs.Float64
+	return &loudnessLufs, nil
+}
+
+// Created is the resolver for the created field.
+func (r *sourceMediaInfoResolver) Created(ctx context.Context, obj *database.SourceMediaInfo) (string, error) {
+	return obj.Created.String(), nil
+}
+
+// LastError is the resolver for the lastError field.
+func (r *stripeEventResolver) LastError(ctx context.Context, obj *database.StripeEvent) (*string, error) {
+	if obj.LastError.Valid == false {
+		return nil, nil
+	}
+	lastError := obj.LastError.String
+	return &lastError, nil
+}
+
+// Created is the resolver for the created field.
+func (r *stripeEventResolver) Created(ctx context.Context, obj *database.StripeEvent) (string, error) {
+	return obj.Created.String(), nil
+}
+
+// Processed is the resolver for the processed field.
+func (r *stripeEventResolver) Processed(ctx context.Context, obj *database.StripeEvent) (*string, error) {
+	if obj.Processed.Valid == false {
+		return nil, nil
+	}
+	processed := obj.Processed.Time.String()
+	return &processed, nil
+}
+
 // StripeSubscriptionID is the resolver for the stripeSubscriptionId field.
 func (r *subscriptionPlanResolver) StripeSubscriptionID(ctx context.Context, obj *database.SubscriptionPlan) (*string, error) {
 	if obj.StripeSubscriptionID.Valid == false {
@@ -497,6 +998,20 @@ func (r *subscriptionPlanResolver) StripeSubscriptionID(ctx context.Context, obj
 	return &subscriptionId, nil
 }
 
+// PastDueSince is the resolver for the pastDueSince field.
+func (r *subscriptionPlanResolver) PastDueSince(ctx context.Context, obj *database.SubscriptionPlan) (*string, error) {
+	if obj.PastDueSince.Valid == false {
+		return nil, nil
+	}
+	pastDueSince := obj.PastDueSince.Time.String()
+	return &pastDueSince, nil
+}
+
+// ReservedCredits is the resolver for the reservedCredits field.
+func (r *subscriptionPlanResolver) ReservedCredits(ctx context.Context, obj *database.SubscriptionPlan) (int64, error) {
+	return r.DB.GetHeldCreditsByTeamId(ctx, obj.TeamID)
+}
+
 // SubscriptionData is the resolver for the subscriptionData field.
 func (r *subscriptionPlanResolver) SubscriptionData(ctx context.Context, obj *database.SubscriptionPlan) (*model.SubscriptionData, error) {
 	if obj.StripeSubscriptionID.Valid == false {
@@ -572,6 +1087,16 @@ func (r *teamResolver) Invitees(ctx context.Context, obj *database.Team) ([]data
 	return inviteeEmails, nil
 }
 
+// RetentionPolicies is the resolver for the retentionPolicies field.
+func (r *teamResolver) RetentionPolicies(ctx context.Context, obj *database.Team) ([]database.RetentionPolicy, error) {
+	return r.DB.GetRetentionPoliciesByTeamId(ctx, obj.ID)
+}
+
+// TranslationModels is the resolver for the translationModels field.
+func (r *teamResolver) TranslationModels(ctx context.Context, obj *database.Team) ([]database.TranslationModel, error) {
+	return r.DB.GetTranslationModelsByTeamId(ctx, obj.ID)
+}
+
 // InviteSlug is the resolver for the inviteSlug field.
 func (r *teamInviteResolver) InviteSlug(ctx context.Context, obj *database.TeamInvite) (string, error) {
 	return obj.Slug, nil
@@ -611,6 +1136,74 @@ func (r *transformationResolver) Transcript(ctx context.Context, obj *database.T
 	return string(jsonBytes), nil
 }
 
+// QueuePosition is the resolver for the queuePosition field.
+func (r *transformationResolver) QueuePosition(ctx context.Context, obj *database.Transformation) (*int, error) {
+	position := r.Scheduler.QueuePosition(obj.ID)
+	if position == 0 {
+		return nil, nil
+	}
+	return &position, nil
+}
+
+// Assets is the resolver for the assets field.
+func (r *transformationResolver) Assets(ctx context.Context, obj *database.Transformation) ([]database.MediaAsset, error) {
+	return r.DB.GetMediaAssetsByTransformationId(ctx, sql.NullInt64{Int64: obj.ID, Valid: true})
+}
+
+// TranslationQa is the resolver for the translationQa field.
+func (r *transformationResolver) TranslationQa(ctx context.Context, obj *database.Transformation) (*model.TranslationQaReport, error) {
+	segments, err := r.DB.GetTranslationQaSegmentsByTransformationId(ctx, obj.ID)
+	if err != nil || len(segments) == 0 {
+		return nil, nil
+	}
+
+	report := model.TranslationQaReport{Segments: segments}
+	totalScore := 0.0
+	for _, segment := range segments {
+		if segment.Flagged {
+			report.FlaggedSegments++
+		}
+		if segment.Retranslated {
+			report.RetranslatedSegments++
+		}
+		totalScore += segment.Score
+	}
+	report.AverageScore = totalScore / float64(len(segments))
+
+	return &report, nil
+}
+
+// Temperature is the resolver for the temperature field.
+func (r *translationModelResolver) Temperature(ctx context.Context, obj *database.TranslationModel) (*float64, error) {
+	if obj.Temperature.Valid == false {
+		return nil, nil
+	}
+	temperature := obj.Temperature.Float64
+	return &temperature, nil
+}
+
+// MaxTokens is the resolver for the maxTokens field.
+func (r *translationModelResolver) MaxTokens(ctx context.Context, obj *database.TranslationModel) (*int64, error) {
+	if obj.MaxTokens.Valid == false {
+		return nil, nil
+	}
+	maxTokens := obj.MaxTokens.Int64
+	return &maxTokens, nil
+}
+
+// Created is the resolver for the created field.
+func (r *translationModelResolver) Created(ctx context.Context, obj *database.TranslationModel) (string, error) {
+	return obj.Created.String(), nil
+}
+
+// Created is the resolver for the created field.
+func (r *translationQaSegmentResolver) Created(ctx context.Context, obj *database.TranslationQaSegment) (string, error) {
+	return obj.Created.String(), nil
+}
+
+// MediaAsset returns MediaAssetResolver implementation.
+func (r *Resolver) MediaAsset() MediaAssetResolver { return &mediaAssetResolver{r} }
+
 // Mutation returns MutationResolver implementation.
 func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
 
@@ -620,6 +1213,15 @@ func (r *Resolver) Project() ProjectResolver { return &projectResolver{r} }
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
+// RetentionPolicy returns RetentionPolicyResolver implementation.
+func (r *Resolver) RetentionPolicy() RetentionPolicyResolver { return &retentionPolicyResolver{r} }
+
+// SourceMediaInfo returns SourceMediaInfoResolver implementation.
+func (r *Resolver) SourceMediaInfo() SourceMediaInfoResolver { return &sourceMediaInfoResolver{r} }
+
+// StripeEvent returns StripeEventResolver implementation.
+func (r *Resolver) StripeEvent() StripeEventResolver { return &stripeEventResolver{r} }
+
 // SubscriptionPlan returns SubscriptionPlanResolver implementation.
 func (r *Resolver) SubscriptionPlan() SubscriptionPlanResolver { return &subscriptionPlanResolver{r} }
 
@@ -635,11 +1237,25 @@ func (r *Resolver) TeamMembership() TeamMembershipResolver { return &teamMembers
 // Transformation returns TransformationResolver implementation.
 func (r *Resolver) Transformation() TransformationResolver { return &transformationResolver{r} }
 
+// TranslationModel returns TranslationModelResolver implementation.
+func (r *Resolver) TranslationModel() TranslationModelResolver { return &translationModelResolver{r} }
+
+// TranslationQaSegment returns TranslationQaSegmentResolver implementation.
+func (r *Resolver) TranslationQaSegment() TranslationQaSegmentResolver {
+	return &translationQaSegmentResolver{r}
+}
+
+type mediaAssetResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
 type projectResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type retentionPolicyResolver struct{ *Resolver }
+type sourceMediaInfoResolver struct{ *Resolver }
+type stripeEventResolver struct{ *Resolver }
 type subscriptionPlanResolver struct{ *Resolver }
 type teamResolver struct{ *Resolver }
 type teamInviteResolver struct{ *Resolver }
 type teamMembershipResolver struct{ *Resolver }
 type transformationResolver struct{ *Resolver }
+type translationModelResolver struct{ *Resolver }
+type translationQaSegmentResolver struct{ *Resolver }
@@ -0,0 +1,183 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"planetcastdev/database"
+	"planetcastdev/ffmpegmiddleware"
+	"planetcastdev/graph/model"
+	"planetcastdev/storage"
+	"planetcastdev/utils"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	minUploadPartSize   = int64(8 << 20)
+	maxUploadParts      = int64(10000)
+	maxUploadSize       = int64(10 << 30)
+	uploadPartURLExpiry = 24 * time.Hour
+)
+
+// getUploadPartSize picks the smallest part size that fits the file into the
+// number of parts S3 allows.
+func getUploadPartSize(fileSize int64) int64 {
+	partSize := (fileSize + maxUploadParts - 1) / maxUploadParts
+	if partSize < minUploadPartSize {
+		partSize = minUploadPartSize
+	}
+	return partSize
+}
+
+func getUploadKey(fileName string) string {
+	baseName := strings.TrimSuffix(filepath.Base(fileName), filepath.Ext(fileName))
+	baseName = strings.ReplaceAll(baseName, " ", "_")
+	return fmt.Sprintf("uploads/%s_%s", uuid.NewString(), baseName)
+}
+
+// getUploadSessionResponse signs a URL for every part of the upload. Parts
+// that were already uploaded come back with their ETag so an interrupted
+// upload can skip them.
+func (r *Resolver) getUploadSessionResponse(ctx context.Context, uploadSession database.UploadSession) (model.UploadSessionResponse, error) {
+	uploadedParts, err := r.Storage.Multipart.ListParts(ctx, uploadSession.UploadKey, uploadSession.StorageUploadID)
+	if err != nil {
+		return model.UploadSessionResponse{}, err
+	}
+
+	etags := map[int64]string{}
+	for _, part := range uploadedParts {
+		etags[part.PartNumber] = part.ETag
+	}
+
+	partCount := (uploadSession.FileSize + uploadSession.PartSize - 1) / uploadSession.PartSize
+	parts := []model.UploadPart{}
+
+	for partNumber := int64(1); partNumber <= partCount; partNumber++ {
+		url, err := r.Storage.Multipart.SignedPartURL(ctx, uploadSession.UploadKey, uploadSession.StorageUploadID, partNumber, uploadPartURLExpiry)
+		if err != nil {
+			return model.UploadSessionResponse{}, fmt.Errorf("Could not sign upload part %d: %s", partNumber, err.Error())
+		}
+
+		part := model.UploadPart{PartNumber: partNumber, URL: url}
+		if etag, ok := etags[partNumber]; ok {
+			part.Etag = &etag
+		}
+		parts = append(parts, part)
+	}
+
+	return model.UploadSessionResponse{
+		SessionID: uploadSession.ID,
+		PartSize:  uploadSession.PartSize,
+		Parts:     parts,
+	}, nil
+}
+
+// completeUploadSession assembles the uploaded parts into a single object and
+// checks that it is the size the client announced.
+func (r *Resolver) completeUploadSession(ctx context.Context, uploadSession database.UploadSession, parts []model.CompletedUploadPart) error {
+	completedParts := []storage.CompletedPart{}
+	for _, part := range parts {
+		completedParts = append(completedParts, storage.CompletedPart{PartNumber: part.PartNumber, ETag: part.Etag})
+	}
+
+	completeErr := r.Storage.Multipart.CompleteMultipartUpload(ctx, uploadSession.UploadKey, uploadSession.StorageUploadID, completedParts)
+
+	// A retried request finds the upload already assembled by the first attempt
+	info, err := r.Storage.Stat(ctx, uploadSession.UploadKey)
+	if completeErr != nil && err != nil {
+		return completeErr
+	}
+	if err != nil {
+		return fmt.Errorf("Could not find uploaded file: %s", err.Error())
+	}
+
+	if info.Size != uploadSession.FileSize {
+		r.Storage.DeleteFile(uploadSession.UploadKey)
+		return fmt.Errorf("Uploaded file is %d bytes, expected %d bytes", info.Size, uploadSession.FileSize)
+	}
+
+	return nil
+}
+
+// downloadUpload copies an uploaded object to a temporary file so it can be
+// handed to ffmpeg.
+func (r *Resolver) downloadUpload(ctx context.Context, uploadKey string) (*utils.TempFile, error) {
+	fileName := uuid.NewString() + "_upload"
+
+	err := r.Storage.DownloadFile(ctx, uploadKey, fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	return utils.OpenTempFile(fileName)
+}
+
+// inspectUpload validates a completed upload straight from storage, reading
+// only its headers. Invalid uploads are aborted and deleted.
+func (r *Resolver) inspectUpload(ctx context.Context, uploadSession database.UploadSession) (*ffmpegmiddleware.MediaInfo, error) {
+	info, err := ffmpegmiddleware.InspectURL(ctx, r.Storage.GetFileLink(uploadSession.UploadKey))
+	if err == nil {
+		err = validateSourceMedia(info)
+	}
+	if err == nil {
+		return info, nil
+	}
+
+	_, abortErr := r.DB.AbortUploadSessionById(ctx, uploadSession.ID)
+	if abortErr == nil {
+		r.Storage.DeleteFile(uploadSession.UploadKey)
+	}
+
+	return nil, err
+}
+
+type processUploadedProjectProps struct {
+	Project               database.Project
+	UploadSession         database.UploadSession
+	Gender                string
+	InitialTargetLanguage *string
+	InitialLipSync        bool
+	MediaInfo             *ffmpegmiddleware.MediaInfo
+}
+
+func (r *Resolver) processUploadedProject(ctx context.Context, args processUploadedProjectProps) {
+	uploadKey := args.UploadSession.UploadKey
+
+	file, err := r.downloadUpload(ctx, uploadKey)
+	if err != nil {
+		r.Logger.Error("Could not download uploaded file for project", zap.Error(err), zap.Int64("project_id", args.Project.ID), zap.String("upload_key", uploadKey))
+		return
+	}
+	defer file.Close()
+
+	profile, err := ffmpegmiddleware.GetOutputProfile(string(args.Project.OutputProfile))
+	if err != nil {
+		r.Logger.Error("Could not find output profile for project", zap.Error(err), zap.Int64("project_id", args.Project.ID))
+		return
+	}
+
+	encodedFile, err := r.Ffmpeg.EncodeFile(ctx, file.File, profile)
+	if err != nil {
+		r.Logger.Error("Could not encode uploaded file for project", zap.Error(err), zap.Int64("project_id", args.Project.ID))
+		return
+	}
+
+	baseName := strings.TrimSuffix(filepath.Base(args.UploadSession.FileName), filepath.Ext(args.UploadSession.FileName))
+
+	r.processProjectSource(ctx, processProjectSourceProps{
+		Project:               args.Project,
+		File:                  encodedFile,
+		BaseName:              strings.ReplaceAll(baseName, " ", "_"),
+		Gender:                args.Gender,
+		InitialTargetLanguage: args.InitialTargetLanguage,
+		InitialLipSync:        args.InitialLipSync,
+		MediaInfo:             args.MediaInfo,
+	})
+
+	// The encoded copy is the project's source, the raw upload is no longer needed
+	r.Storage.DeleteFile(uploadKey)
+}
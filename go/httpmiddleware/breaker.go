@@ -0,0 +1,69 @@
+package httpmiddleware
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// Failures in a row that open the circuit
+	breakerFailureThreshold = 5
+	// How long an open circuit rejects requests before one is let through
+	breakerCooldown = 30 * time.Second
+)
+
+// circuitBreaker stops requests to a provider that keeps failing, so jobs
+// fail fast instead of piling up retries against it. Once the cooldown has
+// passed a single request is let through, and its result decides whether
+// the circuit closes again.
+type circuitBreaker struct {
+	mutex     sync.Mutex
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+func (b *circuitBreaker) allow() bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.failures < breakerFailureThreshold {
+		return true
+	}
+	if time.Now().Before(b.openUntil) || b.probing {
+		return false
+	}
+
+	b.probing = true
+	return true
+}
+
+// record counts a result against the provider. Only failures on the
+// provider's side count, a request it rejected means the provider is up.
+func (b *circuitBreaker) record(err error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.probing = false
+
+	if err == nil || !isProviderFailure(err) {
+		b.failures = 0
+		return
+	}
+
+	b.failures++
+	if b.failures >= breakerFailureThreshold {
+		b.openUntil = time.Now().Add(breakerCooldown)
+	}
+}
+
+// Rate limits are handled by waiting, they do not mean the provider is down.
+func isProviderFailure(err error) bool {
+	var requestError *RequestError
+	if !errors.As(err, &requestError) {
+		return false
+	}
+	return requestError.Retryable && requestError.StatusCode != http.StatusTooManyRequests
+}
@@ -1,52 +1,254 @@
 package httpmiddleware
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Providers requests are grouped under for circuit breaking, by host. Any
+// other host is its own provider.
+var hostProviders = map[string]string{
+	"api.openai.com":     "openai",
+	"api.elevenlabs.io":  "elevenlabs",
+	"api.replicate.com":  "replicate",
+	"replicate.delivery": "replicate",
+}
+
+// How long a single attempt may take, by host. Chat completions and speech
+// are generated while the request is open, so they get longer.
+var hostTimeouts = map[string]time.Duration{
+	"api.openai.com":    2 * time.Minute,
+	"api.elevenlabs.io": 2 * time.Minute,
+	"api.replicate.com": 30 * time.Second,
+}
+
+const (
+	defaultTimeout = time.Minute
+	// Downloads are media files, the timeout covers the whole transfer
+	downloadTimeout = 30 * time.Minute
+	// Attempts made when a request does not set its own
+	defaultAttempts = 3
 )
 
 type HttpRequestStruct struct {
-	Method  string
-	Url     string
-	Body    io.Reader
+	Method string
+	Url    string
+	// Held as bytes so the request can be sent again when it is retried
+	Body    []byte
 	Headers map[string]string
+	// Attempts made before giving up, 1 disables retries
+	Attempts int
+	// Overrides the timeout for the host
+	Timeout time.Duration
+	// Idempotent marks a POST that is safe to send twice. Other POSTs are
+	// only retried when the server turned them away.
+	Idempotent bool
 }
 
-func HttpRequest(args HttpRequestStruct) ([]byte, error) {
+// Client is the HTTP client shared by every middleware. Connections are
+// pooled per host, failed requests are retried when the failure is
+// temporary and providers that keep failing are cut off for a while.
+type Client struct {
+	logger   *zap.Logger
+	client   *http.Client
+	mutex    sync.Mutex
+	breakers map[string]*circuitBreaker
+}
 
-	req, err := http.NewRequest(args.Method, args.Url, args.Body)
+type HttpConnectProps struct {
+	Logger *zap.Logger
+}
 
-	if err != nil {
-		return nil, fmt.Errorf("Failed to create request: " + err.Error())
+func Connect(args HttpConnectProps) *Client {
+	transport := &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   10 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          100,
+		MaxIdleConnsPerHost:   20,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: time.Second,
 	}
 
-	for key, val := range args.Headers {
-		req.Header.Set(key, val)
+	return &Client{
+		logger:   args.Logger,
+		client:   &http.Client{Transport: transport},
+		breakers: map[string]*circuitBreaker{},
 	}
+}
+
+func getProvider(host string) string {
+	if provider, ok := hostProviders[host]; ok {
+		return provider
+	}
+	return host
+}
+
+func (c *Client) getBreaker(provider string) *circuitBreaker {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	breaker, ok := c.breakers[provider]
+	if !ok {
+		breaker = &circuitBreaker{}
+		c.breakers[provider] = breaker
+	}
+	return breaker
+}
+
+// HttpRequest sends the request and returns the response body, retrying
+// temporary failures.
+func (c *Client) HttpRequest(ctx context.Context, args HttpRequestStruct) ([]byte, error) {
+	var responseBody []byte
+
+	err := c.do(ctx, args, func(res *http.Response) error {
+		var err error
+		responseBody, err = io.ReadAll(res.Body)
+		if err != nil {
+			return &RequestError{Message: "Failed to read response body: " + err.Error(), Retryable: true}
+		}
+		return nil
+	})
+
+	return responseBody, err
+}
+
+// DownloadFile streams the response body of a request straight to filePath
+// so large media files are never held in memory.
+func (c *Client) DownloadFile(ctx context.Context, args HttpRequestStruct, filePath string) error {
+	if args.Timeout == 0 {
+		args.Timeout = downloadTimeout
+	}
+
+	return c.do(ctx, args, func(res *http.Response) error {
+		file, err := os.Create(filePath)
+		if err != nil {
+			return fmt.Errorf("Failed to create file %s: %s", filePath, err.Error())
+		}
 
-	client := &http.Client{}
+		_, err = io.Copy(file, res.Body)
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
 
-	res, err := client.Do(req)
+		if err != nil {
+			os.Remove(filePath)
+			return &RequestError{Message: fmt.Sprintf("Failed to write response body to %s: %s", filePath, err.Error()), Retryable: true}
+		}
+		return nil
+	})
+}
 
+// do runs the request until it succeeds, fails permanently or runs out of
+// attempts. readBody is called with every successful response.
+func (c *Client) do(ctx context.Context, args HttpRequestStruct, readBody func(res *http.Response) error) error {
+	parsedUrl, err := url.Parse(args.Url)
 	if err != nil {
-		return nil, fmt.Errorf("Failed to fetch response: " + err.Error())
+		return fmt.Errorf("Failed to create request: %s", err.Error())
 	}
 
-	defer res.Body.Close()
+	provider := getProvider(parsedUrl.Host)
+	breaker := c.getBreaker(provider)
+
+	timeout := args.Timeout
+	if timeout == 0 {
+		timeout = hostTimeouts[parsedUrl.Host]
+	}
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+
+	attempts := args.Attempts
+	if attempts <= 0 {
+		attempts = defaultAttempts
+	}
+
+	for attempt := 0; ; attempt++ {
+		if !breaker.allow() {
+			return fmt.Errorf("Requests to %s are paused after repeated failures", provider)
+		}
+
+		err = c.attempt(ctx, args, timeout, readBody)
+		breaker.record(err)
+		if err == nil {
+			return nil
+		}
+
+		if !IsRetryable(err) || !canResend(args, err) || attempt+1 >= attempts || ctx.Err() != nil {
+			return err
+		}
+
+		delay := getRetryDelay(attempt, err)
+		c.logger.Warn(
+			"Request failed, retrying after sleeping",
+			zap.Error(err),
+			zap.String("provider", provider),
+			zap.String("method", args.Method),
+			zap.Int("attempts_left", attempts-attempt-1),
+			zap.Duration("sleep_time", delay),
+		)
+
+		err = Sleep(ctx, delay)
+		if err != nil {
+			return err
+		}
+	}
+}
 
-	responseBody, err := io.ReadAll(res.Body)
+func (c *Client) attempt(ctx context.Context, args HttpRequestStruct, timeout time.Duration, readBody func(res *http.Response) error) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
-	// Error out if response code is not 200 or 202.
-	// But what if the response code is okay but not equal to 200 or 202?
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusAccepted && res.StatusCode != http.StatusCreated {
-		return nil, fmt.Errorf("Request failed: %d %s", res.StatusCode, responseBody)
+	var body io.Reader
+	if args.Body != nil {
+		body = bytes.NewReader(args.Body)
 	}
 
+	req, err := http.NewRequestWithContext(ctx, args.Method, args.Url, body)
 	if err != nil {
-		return nil, fmt.Errorf("Failed to read response body: " + err.Error())
+		return fmt.Errorf("Failed to create request: %s", err.Error())
 	}
 
-	return responseBody, nil
+	for key, val := range args.Headers {
+		req.Header.Set(key, val)
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return classifyTransportError(err)
+	}
+	defer res.Body.Close()
 
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return newStatusError(res)
+	}
+
+	return readBody(res)
+}
+
+// Sleep waits for the duration, or until the context is done.
+func Sleep(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
@@ -0,0 +1,118 @@
+package httpmiddleware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	baseRetryDelay = time.Second
+	maxRetryDelay  = time.Minute
+	// Retry-After values past this are not waited for
+	maxRetryAfter = 5 * time.Minute
+)
+
+// RequestError is a failed request, and whether trying it again might work.
+type RequestError struct {
+	Message    string
+	StatusCode int
+	Retryable  bool
+	// How long the server asked us to wait, if it did
+	RetryAfter time.Duration
+}
+
+func (e *RequestError) Error() string {
+	return e.Message
+}
+
+// IsRetryable reports whether a request failed in a way that could succeed
+// if it were sent again.
+func IsRetryable(err error) bool {
+	var requestError *RequestError
+	return errors.As(err, &requestError) && requestError.Retryable
+}
+
+// Rate limits, timeouts and server errors are worth retrying, any other
+// status means the request itself is wrong.
+func isRetryableStatus(statusCode int) bool {
+	switch statusCode {
+	case http.StatusRequestTimeout, http.StatusTooEarly, http.StatusTooManyRequests,
+		http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+func newStatusError(res *http.Response) *RequestError {
+	responseBody, _ := io.ReadAll(io.LimitReader(res.Body, 4096))
+
+	return &RequestError{
+		Message:    fmt.Sprintf("Request failed: %d %s", res.StatusCode, responseBody),
+		StatusCode: res.StatusCode,
+		Retryable:  isRetryableStatus(res.StatusCode),
+		RetryAfter: parseRetryAfter(res.Header.Get("Retry-After")),
+	}
+}
+
+// classifyTransportError wraps an error from sending the request. Anything
+// short of the caller giving up, like a refused connection or a timed out
+// attempt, is worth retrying.
+func classifyTransportError(err error) *RequestError {
+	return &RequestError{
+		Message:   "Failed to fetch response: " + err.Error(),
+		Retryable: !errors.Is(err, context.Canceled),
+	}
+}
+
+// canResend reports whether a failed request may be sent again. A POST that
+// timed out or failed with a server error may already have been acted on,
+// so unless it is idempotent it is only resent when it was clearly refused.
+func canResend(args HttpRequestStruct, err error) bool {
+	if args.Idempotent || (args.Method != http.MethodPost && args.Method != http.MethodPatch) {
+		return true
+	}
+
+	var requestError *RequestError
+	if !errors.As(err, &requestError) {
+		return false
+	}
+	return requestError.StatusCode == http.StatusTooManyRequests || requestError.StatusCode == http.StatusServiceUnavailable
+}
+
+// parseRetryAfter reads a Retry-After header, given either in seconds or as
+// a date.
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return time.Until(date)
+	}
+
+	return 0
+}
+
+// getRetryDelay returns how long to wait before the next attempt. The server's
+// Retry-After is honoured when given, otherwise the delay doubles with every
+// attempt with full jitter so retries from parallel jobs spread out.
+func getRetryDelay(attempt int, err error) time.Duration {
+	var requestError *RequestError
+	if errors.As(err, &requestError) && requestError.RetryAfter > 0 {
+		return time.Duration(math.Min(float64(requestError.RetryAfter), float64(maxRetryAfter)))
+	}
+
+	ceiling := math.Min(float64(baseRetryDelay)*math.Pow(2, float64(attempt)), float64(maxRetryDelay))
+	return time.Duration(rand.Int63n(int64(ceiling)) + 1)
+}
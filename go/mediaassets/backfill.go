@@ -0,0 +1,118 @@
+package mediaassets
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"planetcastdev/database"
+	"planetcastdev/storage"
+
+	"go.uber.org/zap"
+)
+
+// Projects created before media assets were recorded keep their source under
+// project.source_media and their background audio next to it.
+func getLegacyStemKey(sourceKey string) string {
+	return fmt.Sprintf("%s-demucs.mp3", sourceKey)
+}
+
+// recordLegacyAsset records an object that was stored without a media asset.
+// Objects that no longer exist are skipped.
+func (m *MediaAssets) recordLegacyAsset(ctx context.Context, projectId int64, transformationId sql.NullInt64, kind database.MediaAssetKind, key string) error {
+	object, err := m.storage.Stat(ctx, key)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Could not find %s: %s", key, err.Error())
+	}
+
+	body, err := m.storage.Get(ctx, key)
+	if err != nil {
+		return fmt.Errorf("Could not read %s: %s", key, err.Error())
+	}
+	defer body.Close()
+
+	hash := sha256.New()
+	_, err = io.Copy(hash, body)
+	if err != nil {
+		return fmt.Errorf("Could not read %s: %s", key, err.Error())
+	}
+
+	_, err = m.database.CreateMediaAsset(ctx, database.CreateMediaAssetParams{
+		ProjectID:        projectId,
+		TransformationID: transformationId,
+		Kind:             kind,
+		StorageKey:       key,
+		SizeBytes:        object.Size,
+		Checksum:         hex.EncodeToString(hash.Sum(nil)),
+	})
+	if err != nil {
+		return fmt.Errorf("Could not record media asset %s: %s", key, err.Error())
+	}
+	return nil
+}
+
+// backfillLegacyAssets records the source, background audio and dubs of
+// projects that predate media assets, so dubbing can find them, deleting a
+// dub removes its file and the sweeper does not delete them as orphans. It
+// reports whether every project was recorded.
+func (m *MediaAssets) backfillLegacyAssets(ctx context.Context) bool {
+	transformations, err := m.database.GetSourceTransformationsWithoutMediaAssets(ctx)
+	if err != nil {
+		m.logger.Error("Could not fetch projects without media assets", zap.Error(err))
+		return false
+	}
+
+	ok := true
+	for _, transformation := range transformations {
+		_, err := m.database.GetMediaAssetByProjectIdKind(ctx, database.GetMediaAssetByProjectIdKindParams{
+			ProjectID: transformation.ProjectID,
+			Kind:      database.MediaAssetKindSOURCE,
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			err = m.recordLegacyAsset(ctx, transformation.ProjectID, sql.NullInt64{}, database.MediaAssetKindSOURCE, transformation.SourceMedia)
+		}
+		if err != nil {
+			m.logger.Error("Could not backfill source media", zap.Error(err), zap.Int64("project_id", transformation.ProjectID))
+			ok = false
+		}
+
+		transformationId := sql.NullInt64{Int64: transformation.ID, Valid: true}
+		_, err = m.database.GetMediaAssetByTransformationIdKind(ctx, database.GetMediaAssetByTransformationIdKindParams{
+			TransformationID: transformationId,
+			Kind:             database.MediaAssetKindSTEM,
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			err = m.recordLegacyAsset(ctx, transformation.ProjectID, transformationId, database.MediaAssetKindSTEM, getLegacyStemKey(transformation.TargetMedia))
+		}
+		if err != nil {
+			m.logger.Error("Could not backfill background audio", zap.Error(err), zap.Int64("project_id", transformation.ProjectID))
+			ok = false
+		}
+	}
+
+	dubs, err := m.database.GetDubbedTransformationsWithoutMediaAssets(ctx)
+	if err != nil {
+		m.logger.Error("Could not fetch dubs without media assets", zap.Error(err))
+		return false
+	}
+
+	for _, dub := range dubs {
+		transformationId := sql.NullInt64{Int64: dub.ID, Valid: true}
+		err := m.recordLegacyAsset(ctx, dub.ProjectID, transformationId, database.MediaAssetKindDUB, dub.TargetMedia)
+		if err != nil {
+			m.logger.Error("Could not backfill dubbed media", zap.Error(err), zap.Int64("transformation_id", dub.ID))
+			ok = false
+		}
+	}
+
+	if len(transformations) > 0 || len(dubs) > 0 {
+		m.logger.Info("Backfilled legacy media assets", zap.Int("projects", len(transformations)), zap.Int("dubs", len(dubs)), zap.Bool("complete", ok))
+	}
+	return ok
+}
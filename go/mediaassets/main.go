@@ -0,0 +1,119 @@
+package mediaassets
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"planetcastdev/database"
+	"planetcastdev/ffmpegmiddleware"
+	"planetcastdev/storage"
+
+	"go.uber.org/zap"
+)
+
+// MediaAssets keeps the media_asset table in step with storage. Every object
+// a project owns is stored through it, so it can be found and deleted without
+// guessing its key.
+type MediaAssets struct {
+	storage  *storage.Storage
+	database *database.Queries
+	logger   *zap.Logger
+	// backfilled is set once every legacy project has its media recorded,
+	// orphans are not deleted before then
+	backfilled bool
+}
+
+type MediaAssetsConnectProps struct {
+	Storage  *storage.Storage
+	Database *database.Queries
+	Logger   *zap.Logger
+}
+
+func Connect(args MediaAssetsConnectProps) *MediaAssets {
+	return &MediaAssets{
+		storage:  args.Storage,
+		database: args.Database,
+		logger:   args.Logger,
+	}
+}
+
+type StoreProps struct {
+	ProjectID int64
+	// TransformationID is nil for assets that belong to the whole project
+	TransformationID *int64
+	Kind             database.MediaAssetKind
+	Key              string
+	FilePath         string
+}
+
+// Store uploads the file at FilePath under Key and records it.
+func (m *MediaAssets) Store(ctx context.Context, args StoreProps) (database.MediaAsset, error) {
+	file, err := os.Open(args.FilePath)
+	if err != nil {
+		return database.MediaAsset{}, fmt.Errorf("Could not open %s: %s", args.FilePath, err.Error())
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	size, err := io.Copy(hash, file)
+	if err != nil {
+		return database.MediaAsset{}, fmt.Errorf("Could not read %s: %s", args.FilePath, err.Error())
+	}
+
+	// Images and subtitles have no duration, the codec is still recorded
+	duration := sql.NullFloat64{}
+	if seconds, err := ffmpegmiddleware.GetDuration(ctx, args.FilePath); err == nil {
+		duration = sql.NullFloat64{Float64: seconds, Valid: true}
+	}
+
+	codec := sql.NullString{}
+	if codecName, err := ffmpegmiddleware.GetCodec(ctx, args.FilePath); err == nil {
+		codec = sql.NullString{String: codecName, Valid: true}
+	}
+
+	_, err = file.Seek(0, io.SeekStart)
+	if err != nil {
+		return database.MediaAsset{}, err
+	}
+
+	err = m.storage.Put(ctx, args.Key, file)
+	if err != nil {
+		return database.MediaAsset{}, fmt.Errorf("Could not upload %s: %s", args.Key, err.Error())
+	}
+
+	transformationId := sql.NullInt64{}
+	if args.TransformationID != nil {
+		transformationId = sql.NullInt64{Int64: *args.TransformationID, Valid: true}
+	}
+
+	asset, err := m.database.CreateMediaAsset(ctx, database.CreateMediaAssetParams{
+		ProjectID:        args.ProjectID,
+		TransformationID: transformationId,
+		Kind:             args.Kind,
+		StorageKey:       args.Key,
+		SizeBytes:        size,
+		Checksum:         hex.EncodeToString(hash.Sum(nil)),
+		DurationSeconds:  duration,
+		Codec:            codec,
+	})
+	if err != nil {
+		m.storage.DeleteFile(args.Key)
+		return database.MediaAsset{}, fmt.Errorf("Could not record media asset %s: %s", args.Key, err.Error())
+	}
+
+	m.logger.Info("Stored media asset", zap.String("storage_key", args.Key), zap.String("kind", string(args.Kind)), zap.Int64("project_id", args.ProjectID))
+
+	return asset, nil
+}
+
+// DeleteObjects removes the stored objects of assets whose rows are already
+// gone, for example after their project was deleted.
+func (m *MediaAssets) DeleteObjects(assets []database.MediaAsset) {
+	for _, asset := range assets {
+		m.storage.DeleteFile(asset.StorageKey)
+	}
+}
@@ -0,0 +1,287 @@
+package mediaassets
+
+import (
+	"context"
+	"os"
+	"planetcastdev/database"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Uploads that are still pending after this long are abandoned, their parts
+// are removed along with any other orphaned objects.
+const abandonedUploadAge = 7 * 24 * time.Hour
+
+// sweepLockKey is the advisory lock that keeps servers from sweeping at the
+// same time.
+const sweepLockKey int64 = 7350001
+
+var mediaAssetKinds = []database.MediaAssetKind{
+	database.MediaAssetKindSOURCE,
+	database.MediaAssetKindSTEM,
+	database.MediaAssetKindDUB,
+	database.MediaAssetKindSUBTITLE,
+	database.MediaAssetKindPREVIEW,
+	database.MediaAssetKindTHUMBNAIL,
+}
+
+func getEnvInt(name string, defaultValue int) int {
+	value, err := strconv.Atoi(os.Getenv(name))
+	if err != nil || value < 0 {
+		return defaultValue
+	}
+	return value
+}
+
+func getSweepInterval() time.Duration {
+	return time.Duration(getEnvInt("STORAGE_SWEEP_INTERVAL_HOURS", 6)) * time.Hour
+}
+
+// getOrphanGracePeriod is how old an unknown object has to be before it is
+// deleted. Dubbing jobs upload files before they are recorded, the grace
+// period keeps the sweeper away from jobs that are still running.
+func getOrphanGracePeriod() time.Duration {
+	return time.Duration(getEnvInt("STORAGE_ORPHAN_GRACE_HOURS", 24)) * time.Hour
+}
+
+// getDefaultRetentionDays returns how long assets of a kind are kept when the
+// team has no policy of its own, 0 keeps them forever. Stems are only needed
+// to dub new languages and can be separated again from the source.
+func getDefaultRetentionDays(kind database.MediaAssetKind) int64 {
+	switch kind {
+	case database.MediaAssetKindSTEM:
+		return int64(getEnvInt("STEM_RETENTION_DAYS", 30))
+	default:
+		return 0
+	}
+}
+
+// getCacheRetention is how long a cached translation or speech clip is kept
+// after it was last used.
+func getCacheRetention() time.Duration {
+	return time.Duration(getEnvInt("DUBBING_CACHE_RETENTION_DAYS", 90)) * 24 * time.Hour
+}
+
+// RunSweeper sweeps storage now and then every STORAGE_SWEEP_INTERVAL_HOURS
+// until the context is cancelled.
+func (m *MediaAssets) RunSweeper(ctx context.Context) {
+	ticker := time.NewTicker(getSweepInterval())
+	defer ticker.Stop()
+
+	for {
+		m.Sweep(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sweep records media of legacy projects, expires assets under their
+// retention policies, abandons stale uploads and deletes objects that nothing
+// in the database refers to. It is skipped while another server is sweeping.
+func (m *MediaAssets) Sweep(ctx context.Context) {
+	locked, err := m.database.TryAdvisoryLock(ctx, sweepLockKey, func() error {
+		m.sweep(ctx)
+		return nil
+	})
+	if err != nil {
+		m.logger.Error("Could not lock storage sweep", zap.Error(err))
+		return
+	}
+	if locked == false {
+		m.logger.Info("Storage sweep is running on another server, skipping")
+	}
+}
+
+func (m *MediaAssets) sweep(ctx context.Context) {
+	if m.backfilled == false {
+		m.backfilled = m.backfillLegacyAssets(ctx)
+	}
+
+	for _, kind := range mediaAssetKinds {
+		m.enforceRetention(ctx, kind)
+	}
+	m.evictUnusedCacheEntries(ctx)
+	m.abandonStaleUploads(ctx)
+	if m.backfilled {
+		m.deleteOrphanedObjects(ctx)
+	}
+}
+
+func (m *MediaAssets) enforceRetention(ctx context.Context, kind database.MediaAssetKind) {
+	policies, err := m.database.GetRetentionPoliciesByKind(ctx, kind)
+	if err != nil {
+		m.logger.Error("Could not fetch retention policies", zap.Error(err), zap.String("kind", string(kind)))
+		return
+	}
+
+	defaultDays := getDefaultRetentionDays(kind)
+	if defaultDays == 0 && len(policies) == 0 {
+		return
+	}
+
+	teamDays := map[int64]int64{}
+	for _, policy := range policies {
+		teamDays[policy.TeamID] = policy.RetentionDays
+	}
+
+	assets, err := m.database.GetMediaAssetsWithTeamIdByKind(ctx, kind)
+	if err != nil {
+		m.logger.Error("Could not fetch media assets", zap.Error(err), zap.String("kind", string(kind)))
+		return
+	}
+
+	expired := 0
+	for _, asset := range assets {
+		days, ok := teamDays[asset.TeamID]
+		if ok == false {
+			days = defaultDays
+		}
+		if days == 0 || time.Since(asset.Created) < time.Duration(days)*24*time.Hour {
+			continue
+		}
+
+		err := m.storage.Delete(ctx, asset.StorageKey)
+		if err == nil {
+			_, err = m.database.DeleteMediaAssetById(ctx, asset.ID)
+		}
+		if err != nil {
+			m.logger.Error("Could not delete expired media asset", zap.Error(err), zap.String("storage_key", asset.StorageKey))
+			continue
+		}
+		expired++
+	}
+
+	if expired > 0 {
+		m.logger.Info("Deleted expired media assets", zap.String("kind", string(kind)), zap.Int("assets", expired))
+	}
+}
+
+func (m *MediaAssets) evictUnusedCacheEntries(ctx context.Context) {
+	entries, err := m.database.GetDubbingCacheEntriesUnusedSince(ctx, time.Now().Add(-getCacheRetention()))
+	if err != nil {
+		m.logger.Error("Could not fetch unused dubbing cache entries", zap.Error(err))
+		return
+	}
+
+	for _, entry := range entries {
+		err := m.storage.Delete(ctx, entry.StorageKey)
+		if err == nil {
+			err = m.database.DeleteDubbingCacheEntryById(ctx, entry.ID)
+		}
+		if err != nil {
+			m.logger.Error("Could not evict dubbing cache entry", zap.Error(err), zap.String("storage_key", entry.StorageKey))
+		}
+	}
+}
+
+func (m *MediaAssets) abandonStaleUploads(ctx context.Context) {
+	if m.storage.Multipart == nil {
+		return
+	}
+
+	uploadSessions, err := m.database.GetUploadSessionsByStatus(ctx, database.UploadStatusPENDING)
+	if err != nil {
+		m.logger.Error("Could not fetch pending upload sessions", zap.Error(err))
+		return
+	}
+
+	for _, uploadSession := range uploadSessions {
+		if time.Since(uploadSession.Created) < abandonedUploadAge {
+			continue
+		}
+
+		err := m.storage.Multipart.AbortMultipartUpload(ctx, uploadSession.UploadKey, uploadSession.StorageUploadID)
+		if err != nil {
+			m.logger.Error("Could not abort abandoned upload", zap.Error(err), zap.Int64("upload_session_id", uploadSession.ID))
+			continue
+		}
+		m.database.AbortUploadSessionById(ctx, uploadSession.ID)
+	}
+}
+
+// getKnownKeys collects every key the database refers to. Project and
+// transformation media are included so objects a running job has not
+// recorded yet are never mistaken for orphans.
+func (m *MediaAssets) getKnownKeys(ctx context.Context) (map[string]bool, error) {
+	knownKeys := map[string]bool{}
+
+	assetKeys, err := m.database.GetMediaAssetStorageKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sourceMedia, err := m.database.GetProjectSourceMedia(ctx)
+	if err != nil {
+		return nil, err
+	}
+	targetMedia, err := m.database.GetTransformationTargetMedia(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cacheKeys, err := m.database.GetDubbingCacheEntryStorageKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, keys := range [][]string{assetKeys, sourceMedia, targetMedia, cacheKeys} {
+		for _, key := range keys {
+			knownKeys[key] = true
+		}
+	}
+
+	uploadSessions, err := m.database.GetUploadSessionsByStatus(ctx, database.UploadStatusPENDING)
+	if err != nil {
+		return nil, err
+	}
+	for _, uploadSession := range uploadSessions {
+		knownKeys[uploadSession.UploadKey] = true
+	}
+
+	return knownKeys, nil
+}
+
+func (m *MediaAssets) deleteOrphanedObjects(ctx context.Context) {
+	// Read the database before storage, anything recorded after this point
+	// was uploaded recently and is protected by the grace period
+	knownKeys, err := m.getKnownKeys(ctx)
+	if err != nil {
+		m.logger.Error("Could not fetch stored media keys", zap.Error(err))
+		return
+	}
+
+	objects, err := m.storage.List(ctx, "")
+	if err != nil {
+		m.logger.Error("Could not list storage objects", zap.Error(err))
+		return
+	}
+
+	gracePeriod := getOrphanGracePeriod()
+	// Orphans are only reported until deletion is turned on
+	dryRun := os.Getenv("STORAGE_SWEEP_DELETE_ORPHANS") != "true"
+
+	deleted := 0
+	for _, object := range objects {
+		if knownKeys[object.Key] || time.Since(object.LastModified) < gracePeriod {
+			continue
+		}
+
+		if dryRun {
+			m.logger.Info("Found orphaned storage object", zap.String("storage_key", object.Key), zap.Int64("size", object.Size))
+			continue
+		}
+
+		err := m.storage.Delete(ctx, object.Key)
+		if err != nil {
+			m.logger.Error("Could not delete orphaned storage object", zap.Error(err), zap.String("storage_key", object.Key))
+			continue
+		}
+		deleted++
+	}
+
+	m.logger.Info("Swept storage", zap.Int("objects", len(objects)), zap.Int("deleted", deleted), zap.Bool("dry_run", dryRun))
+}
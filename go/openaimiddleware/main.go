@@ -1,27 +1,39 @@
 package openaimiddleware
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"os"
 	"planetcastdev/httpmiddleware"
-	"planetcastdev/utils"
-	"time"
+	"planetcastdev/scheduler"
+	"sort"
+	"strings"
 
 	"go.uber.org/zap"
-	"golang.org/x/sync/semaphore"
 )
 
+const DefaultProvider = "openai"
+
 type ChatCompletionMessage struct {
 	Role    string `json:"role"`
 	Content string `json:"content"`
 }
 
+type ResponseFormat struct {
+	Type string `json:"type"`
+}
+
+// JSONResponse makes the model answer with a single JSON object. The prompt
+// must still ask for JSON.
+var JSONResponse = &ResponseFormat{Type: "json_object"}
+
 type ChatRequestInput struct {
-	Model    string                  `json:"model"`
-	Messages []ChatCompletionMessage `json:"messages"`
+	Model          string                  `json:"model"`
+	Messages       []ChatCompletionMessage `json:"messages"`
+	Temperature    *float64                `json:"temperature,omitempty"`
+	MaxTokens      int64                   `json:"max_tokens,omitempty"`
+	ResponseFormat *ResponseFormat         `json:"response_format,omitempty"`
 }
 
 type ChatCompletionChoice struct {
@@ -29,97 +41,223 @@ type ChatCompletionChoice struct {
 	Message ChatCompletionMessage `json:"message"`
 }
 
+type Usage struct {
+	PromptTokens     int64 `json:"prompt_tokens"`
+	CompletionTokens int64 `json:"completion_tokens"`
+	TotalTokens      int64 `json:"total_tokens"`
+}
+
+func (u *Usage) Add(other Usage) {
+	u.PromptTokens += other.PromptTokens
+	u.CompletionTokens += other.CompletionTokens
+	u.TotalTokens += other.TotalTokens
+}
+
 type ChatCompletionResponse struct {
 	ID      string                 `json:"id"`
 	Object  string                 `json:"object"`
 	Created int64                  `json:"created"`
 	Model   string                 `json:"model"`
 	Choices []ChatCompletionChoice `json:"choices"`
+	Usage   Usage                  `json:"usage"`
+}
+
+// DecodeJSON reads the answer of a request made with JSONResponse.
+func (c *ChatCompletionResponse) DecodeJSON(v any) error {
+	err := json.Unmarshal([]byte(c.Choices[0].Message.Content), v)
+	if err != nil {
+		return fmt.Errorf("Could not parse JSON response: %s", err.Error())
+	}
+	return nil
+}
+
+// provider is an API that speaks OpenAI's chat completions protocol.
+type provider struct {
+	url    string
+	apiKey string
 }
 
 type OpenAIConnectProps struct {
-	Logger *zap.Logger
+	Logger    *zap.Logger
+	Scheduler *scheduler.Scheduler
+	Http      *httpmiddleware.Client
 }
 
 type OpenAI struct {
 	logger    *zap.Logger
-	semaphore *semaphore.Weighted
+	scheduler *scheduler.Scheduler
+	http      *httpmiddleware.Client
+	providers map[string]provider
+}
+
+// getProviders returns OpenAI itself and every compatible provider set up
+// with LLM_PROVIDER_<NAME>_URL and LLM_PROVIDER_<NAME>_KEY.
+func getProviders() map[string]provider {
+	providers := map[string]provider{
+		DefaultProvider: {url: "https://api.openai.com/v1", apiKey: os.Getenv("OPEN_AI_SECRET_KEY")},
+	}
+
+	for _, env := range os.Environ() {
+		key, value, _ := strings.Cut(env, "=")
+		name, found := strings.CutPrefix(key, "LLM_PROVIDER_")
+		if !found || value == "" {
+			continue
+		}
+		name, found = strings.CutSuffix(name, "_URL")
+		if !found {
+			continue
+		}
+
+		providers[strings.ToLower(name)] = provider{
+			url:    strings.TrimSuffix(value, "/"),
+			apiKey: os.Getenv("LLM_PROVIDER_" + name + "_KEY"),
+		}
+	}
+
+	return providers
 }
 
 func Connect(args OpenAIConnectProps) *OpenAI {
-	maxWorkers := 3
-	sem := semaphore.NewWeighted(int64(maxWorkers))
-	return &OpenAI{logger: args.Logger, semaphore: sem}
+	providers := getProviders()
+
+	names := []string{}
+	for name := range providers {
+		names = append(names, name)
+	}
+	args.Logger.Info("Setting Up OpenAI Middleware", zap.Strings("providers", names))
+
+	return &OpenAI{logger: args.Logger, scheduler: args.Scheduler, http: args.Http, providers: providers}
+}
+
+func (o *OpenAI) HasProvider(name string) bool {
+	_, ok := o.providers[name]
+	return ok
 }
 
 type MakeAPIRequestProps struct {
+	// Defaults to OpenAI
+	Provider     string
 	Retries      int
 	RequestInput ChatRequestInput
 }
 
-func (o *OpenAI) MakeAPIRequest(ctx context.Context, args MakeAPIRequestProps) (*ChatCompletionResponse, error) {
+// post sends a request to one of a provider's endpoints, waiting for a free
+// slot first.
+func (o *OpenAI) post(ctx context.Context, providerName string, path string, requestInput any, retries int) ([]byte, error) {
+
+	if providerName == "" {
+		providerName = DefaultProvider
+	}
+	provider, ok := o.providers[providerName]
+	if !ok {
+		return nil, fmt.Errorf("Unknown LLM provider: %s", providerName)
+	}
+
+	jsonData, err := json.Marshal(requestInput)
+	if err != nil {
+		return nil, fmt.Errorf("Could not generate request body: " + err.Error())
+	}
+
+	release, err := o.scheduler.Acquire(ctx, scheduler.OpenAI)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
 
-	API_KEY := os.Getenv("OPEN_AI_SECRET_KEY")
-	URL := "https://api.openai.com/v1/chat/completions"
+	respBody, err := o.http.HttpRequest(ctx, httpmiddleware.HttpRequestStruct{
+		Method: "POST",
+		Url:    provider.url + path,
+		Body:   jsonData,
+		Headers: map[string]string{
+			"Authorization": "Bearer " + provider.apiKey,
+			"Content-Type":  "application/json",
+		},
+		Attempts: retries + 1,
+		// Completions and embeddings change nothing on the provider's side
+		Idempotent: true,
+	})
+	if err != nil {
+		o.logger.Error("Could not make request to OpenAI", zap.Error(err), zap.String("provider", providerName), zap.Any("request_input", requestInput))
+		return nil, fmt.Errorf("Open AI Requests Failed: %s", err.Error())
+	}
+
+	return respBody, nil
+}
+
+func (o *OpenAI) MakeAPIRequest(ctx context.Context, args MakeAPIRequestProps) (*ChatCompletionResponse, error) {
 
 	chatGptInput := args.RequestInput
-	retries := args.Retries
 
-	jsonData, err := json.Marshal(chatGptInput)
+	respBody, err := o.post(ctx, args.Provider, "/chat/completions", chatGptInput, args.Retries)
 	if err != nil {
-		return nil, fmt.Errorf("Could not generate request body: " + err.Error())
+		return nil, err
+	}
+
+	var chatResponse ChatCompletionResponse
+	err = json.Unmarshal(respBody, &chatResponse)
+	if err != nil || len(chatResponse.Choices) == 0 {
+		o.logger.Error(
+			"Could not parse OpenAI Request",
+			zap.Error(err),
+			zap.String("provider", args.Provider),
+			zap.String("response_body", string(respBody)),
+			zap.Any("request_input", chatGptInput),
+			zap.Int("chat_choices", len(chatResponse.Choices)),
+		)
+		return nil, fmt.Errorf("Could not parse OpenAI response")
 	}
 
-	for retries >= 0 {
+	return &chatResponse, nil
+}
 
-		sleepTime := utils.GetExponentialDelaySeconds(5 - retries)
+type EmbeddingRequestInput struct {
+	Model string   `json:"model"`
+	Input []string `json:"input"`
+}
 
-		if err := o.semaphore.Acquire(ctx, 1); err != nil {
-			return nil, fmt.Errorf("Failed to acquire semaphore.")
-		}
-		defer o.semaphore.Release(1)
-
-		respBody, err := httpmiddleware.HttpRequest(httpmiddleware.HttpRequestStruct{
-			Method: "POST",
-			Url:    URL,
-			Body:   bytes.NewBuffer(jsonData),
-			Headers: map[string]string{
-				"Authorization": "Bearer " + API_KEY,
-				"Content-Type":  "application/json",
-			},
-		})
-
-		if err != nil {
-			o.logger.Error(
-				"Could not make request to OpenAI. Retrying after sleeping.",
-				zap.Error(err),
-				zap.Int("retries_left", retries),
-				zap.Int("sleep_time", sleepTime),
-				zap.Any("request_input", chatGptInput),
-			)
-			retries -= 1
-			time.Sleep(time.Duration(sleepTime) * time.Second)
-		} else {
-			var chatResponse ChatCompletionResponse
-			err = json.Unmarshal(respBody, &chatResponse)
-			if err != nil || len(chatResponse.Choices) == 0 {
-				retries -= 1
-				o.logger.Error(
-					"Could not parse OpenAI Request. Retying after sleeping.",
-					zap.Int("retries_left", retries),
-					zap.Int("sleep_time", sleepTime),
-					zap.Error(err),
-					zap.String("response_body", string(respBody)),
-					zap.Any("request_input", chatGptInput),
-					zap.Int("chat_choices", len(chatResponse.Choices)),
-				)
-				time.Sleep(time.Duration(sleepTime) * time.Second)
-			} else {
-				return &chatResponse, nil
-			}
-		}
+type Embedding struct {
+	Index     int       `json:"index"`
+	Embedding []float64 `json:"embedding"`
+}
+
+type EmbeddingResponse struct {
+	Model string      `json:"model"`
+	Data  []Embedding `json:"data"`
+	Usage Usage       `json:"usage"`
+}
+
+type MakeEmbeddingRequestProps struct {
+	// Defaults to OpenAI
+	Provider     string
+	Retries      int
+	RequestInput EmbeddingRequestInput
+}
+
+// MakeEmbeddingRequest returns an embedding for every input, in the order
+// they were given.
+func (o *OpenAI) MakeEmbeddingRequest(ctx context.Context, args MakeEmbeddingRequestProps) (*EmbeddingResponse, error) {
+
+	respBody, err := o.post(ctx, args.Provider, "/embeddings", args.RequestInput, args.Retries)
+	if err != nil {
+		return nil, err
+	}
+
+	var embeddingResponse EmbeddingResponse
+	err = json.Unmarshal(respBody, &embeddingResponse)
+	if err != nil || len(embeddingResponse.Data) != len(args.RequestInput.Input) {
+		o.logger.Error(
+			"Could not parse OpenAI embedding response",
+			zap.Error(err),
+			zap.String("provider", args.Provider),
+			zap.String("response_body", string(respBody)),
+			zap.Int("embeddings", len(embeddingResponse.Data)),
+		)
+		return nil, fmt.Errorf("Could not parse OpenAI embedding response")
 	}
 
-	return nil, fmt.Errorf("Open AI Requests Failed")
+	sort.Slice(embeddingResponse.Data, func(i, j int) bool {
+		return embeddingResponse.Data[i].Index < embeddingResponse.Data[j].Index
+	})
 
+	return &embeddingResponse, nil
 }
@@ -0,0 +1,34 @@
+package paymentsmiddleware
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/stripe/stripe-go/v76"
+)
+
+// IsCreditPack reports whether the price is a one-off credit pack rather than
+// a subscription plan.
+func IsCreditPack(packPrice *stripe.Price) bool {
+	return packPrice.Type == stripe.PriceTypeOneTime
+}
+
+// GetCreditPackCredits reads the number of credits a credit pack grants from
+// the `credits` metadata on its price.
+func GetCreditPackCredits(packPrice *stripe.Price) (int64, error) {
+	credits, ok := packPrice.Metadata["credits"]
+	if !ok {
+		return 0, fmt.Errorf("No credits field in the credit pack price %s", packPrice.ID)
+	}
+
+	value, err := strconv.ParseInt(credits, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("Unable to parse credits string in credit pack price %s '%s': %s", packPrice.ID, credits, err.Error())
+	}
+
+	if value <= 0 {
+		return 0, fmt.Errorf("Invalid amount of credits included (%d) in the credit pack price %s", value, packPrice.ID)
+	}
+
+	return value, nil
+}
@@ -0,0 +1,345 @@
+package paymentsmiddleware
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"planetcastdev/database"
+	"planetcastdev/utils"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type ReserveCreditsProps struct {
+	TeamID           int64
+	TransformationID int64
+	Credits          int64
+}
+
+// ReserveCredits places a hold on the team's credits for a dubbing job. The
+// held credits are taken out of the remaining balance straight away so
+// concurrent jobs cannot spend them, and are settled later with CaptureCredits
+// or ReleaseCredits. Teams with overage billing enabled can hold more than
+// their remaining balance, up to their overage spend cap. Teams that have been
+// past due for longer than the grace period cannot start new jobs.
+func (p *Payments) ReserveCredits(ctx context.Context, args ReserveCreditsProps) (database.CreditReservation, error) {
+	var reservation database.CreditReservation
+
+	// The cap comes from Stripe, so it is looked up before the plan is locked
+	// to keep Stripe's latency from blocking the team's other reservations
+	overageCap, capErr := int64(0), fmt.Errorf("Overage billing is not enabled")
+	plan, err := p.database.GetSubscriptionByTeamId(ctx, args.TeamID)
+	if err != nil {
+		capErr = err
+	} else if plan.OverageEnabled {
+		overageCap, capErr = p.getOverageCreditCap(plan)
+	}
+
+	err = p.database.ExecTx(ctx, func(q *database.Queries) error {
+		subPlan, err := q.GetSubscriptionByTeamIdForUpdate(ctx, args.TeamID)
+		if err != nil {
+			return fmt.Errorf("Could not find subscription plan for team %d: %s", args.TeamID, err.Error())
+		}
+
+		if isPastGracePeriod(subPlan) {
+			return fmt.Errorf("Dubbing is paused because the subscription payment is overdue. Please update your payment method to continue.")
+		}
+
+		fromBalance := args.Credits
+		if fromBalance > subPlan.RemainingCredits {
+			fromBalance = subPlan.RemainingCredits
+		}
+		overageCredits := args.Credits - fromBalance
+
+		if overageCredits > 0 {
+			if subPlan.OverageEnabled == false {
+				return fmt.Errorf("No sufficient credits available to process dubbing. Remaining: %d. Required: %d.", subPlan.RemainingCredits, args.Credits)
+			}
+
+			if capErr != nil {
+				return fmt.Errorf("Could not determine overage limit for team %d: %s", args.TeamID, capErr.Error())
+			}
+
+			if subPlan.OverageCreditsUsed+overageCredits > overageCap {
+				return fmt.Errorf(
+					"Overage spend cap reached. Remaining: %d. Overage available: %d. Required: %d.",
+					subPlan.RemainingCredits, overageCap-subPlan.OverageCreditsUsed, args.Credits,
+				)
+			}
+		}
+
+		subPlan, err = q.SetCreditBalancesById(ctx, database.SetCreditBalancesByIdParams{
+			ID:                 subPlan.ID,
+			RemainingCredits:   subPlan.RemainingCredits - fromBalance,
+			OverageCreditsUsed: subPlan.OverageCreditsUsed + overageCredits,
+		})
+		if err != nil {
+			return fmt.Errorf("Could not deduct credits for team %d: %s", args.TeamID, err.Error())
+		}
+
+		reservation, err = q.CreateCreditReservation(ctx, database.CreateCreditReservationParams{
+			TeamID:                 args.TeamID,
+			TransformationID:       sql.NullInt64{Valid: true, Int64: args.TransformationID},
+			ReservedCredits:        args.Credits,
+			ReservedOverageCredits: overageCredits,
+		})
+		if err != nil {
+			return fmt.Errorf("Could not create credit reservation for team %d: %s", args.TeamID, err.Error())
+		}
+
+		p.logger.Info(
+			"Reserved credits for transformation",
+			zap.Int64("team_id", args.TeamID),
+			zap.Int64("transformation_id", args.TransformationID),
+			zap.Int64("reserved_credits", args.Credits),
+			zap.Int64("reserved_overage_credits", overageCredits),
+			zap.Int64("remaining_credits", subPlan.RemainingCredits),
+		)
+
+		return nil
+	})
+
+	return reservation, err
+}
+
+// getCapturedOverage returns how many of a captured reservation's credits went
+// over the team's included credits.
+func getCapturedOverage(reservation database.CreditReservation) int64 {
+	heldFromBalance := reservation.ReservedCredits - reservation.ReservedOverageCredits
+	return utils.Max64(0, reservation.CapturedCredits-heldFromBalance)
+}
+
+// CaptureCredits settles a hold by charging the credits that were actually
+// used. Anything held above that amount is returned to the team. The charge
+// never exceeds the original hold. Included credits are used up first, and
+// whatever overage remains is reported to Stripe as metered usage once the
+// capture is committed. Reports that fail are retried by
+// RunReservationSettler.
+func (p *Payments) CaptureCredits(ctx context.Context, reservationId int64, usedCredits int64) error {
+	var captured *database.CreditReservation
+
+	err := p.database.ExecTx(ctx, func(q *database.Queries) error {
+		reservation, err := q.GetCreditReservationById(ctx, reservationId)
+		if err != nil {
+			return fmt.Errorf("Could not find credit reservation %d: %s", reservationId, err.Error())
+		}
+
+		capturedCredits := usedCredits
+		if capturedCredits > reservation.ReservedCredits {
+			capturedCredits = reservation.ReservedCredits
+		}
+		if capturedCredits < 0 {
+			capturedCredits = 0
+		}
+
+		reservation, err = q.CaptureCreditReservationById(ctx, database.CaptureCreditReservationByIdParams{
+			ID:              reservationId,
+			CapturedCredits: capturedCredits,
+		})
+		if err == sql.ErrNoRows {
+			p.logger.Warn("Credit reservation already settled, skipping capture", zap.Int64("reservation_id", reservationId))
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("Could not capture credit reservation %d: %s", reservationId, err.Error())
+		}
+
+		heldFromBalance := reservation.ReservedCredits - reservation.ReservedOverageCredits
+		capturedFromBalance := capturedCredits
+		if capturedFromBalance > heldFromBalance {
+			capturedFromBalance = heldFromBalance
+		}
+		capturedOverage := capturedCredits - capturedFromBalance
+
+		subPlan, err := q.GetSubscriptionByTeamIdForUpdate(ctx, reservation.TeamID)
+		if err != nil {
+			return fmt.Errorf("Could not find subscription plan for team %d: %s", reservation.TeamID, err.Error())
+		}
+
+		subPlan, err = q.SetCreditBalancesById(ctx, database.SetCreditBalancesByIdParams{
+			ID:                 subPlan.ID,
+			RemainingCredits:   subPlan.RemainingCredits + (heldFromBalance - capturedFromBalance),
+			OverageCreditsUsed: utils.Max64(0, subPlan.OverageCreditsUsed-(reservation.ReservedOverageCredits-capturedOverage)),
+		})
+		if err != nil {
+			return fmt.Errorf("Could not return unused credits to team %d: %s", reservation.TeamID, err.Error())
+		}
+
+		captured = &reservation
+
+		p.logger.Info(
+			"Captured credit reservation",
+			zap.Int64("reservation_id", reservationId),
+			zap.Int64("team_id", reservation.TeamID),
+			zap.Int64("reserved_credits", reservation.ReservedCredits),
+			zap.Int64("captured_credits", capturedCredits),
+			zap.Int64("captured_overage_credits", capturedOverage),
+		)
+
+		return nil
+	})
+	if err != nil || captured == nil || getCapturedOverage(*captured) == 0 {
+		return err
+	}
+
+	err = p.reportCapturedOverage(ctx, *captured)
+	if err != nil {
+		p.logger.Error("Could not report overage usage, it will be retried", zap.Error(err), zap.Int64("reservation_id", reservationId))
+	}
+	return nil
+}
+
+// reportCapturedOverage bills a captured reservation's overage and records
+// that it was billed. Stripe sees the same idempotency key every time, so a
+// report that reached Stripe but was not recorded is not billed twice.
+func (p *Payments) reportCapturedOverage(ctx context.Context, reservation database.CreditReservation) error {
+	subPlan, err := p.database.GetSubscriptionByTeamId(ctx, reservation.TeamID)
+	if err != nil {
+		return fmt.Errorf("Could not find subscription plan for team %d: %s", reservation.TeamID, err.Error())
+	}
+
+	capturedOverage := getCapturedOverage(reservation)
+	err = p.reportOverageUsage(subPlan, reservation.ID, capturedOverage)
+	if err != nil {
+		return fmt.Errorf("Could not report %d overage credits for team %d: %s", capturedOverage, reservation.TeamID, err.Error())
+	}
+
+	return p.database.SetCreditReservationOverageReportedById(ctx, reservation.ID)
+}
+
+// reportPendingOverage retries overage reports that failed after their
+// capture was committed.
+func (p *Payments) reportPendingOverage(ctx context.Context) {
+	reservations, err := p.database.GetUnreportedOverageReservations(ctx)
+	if err != nil {
+		p.logger.Error("Could not fetch unreported overage reservations", zap.Error(err))
+		return
+	}
+
+	for _, reservation := range reservations {
+		err := p.reportCapturedOverage(ctx, reservation)
+		if err != nil {
+			p.logger.Error("Could not report overage usage", zap.Error(err), zap.Int64("reservation_id", reservation.ID))
+		}
+	}
+}
+
+// ReleaseCredits cancels a hold and gives all of the held credits back to the
+// team.
+func (p *Payments) ReleaseCredits(ctx context.Context, reservationId int64) error {
+	return p.database.ExecTx(ctx, func(q *database.Queries) error {
+		reservation, err := q.ReleaseCreditReservationById(ctx, reservationId)
+		if err == sql.ErrNoRows {
+			p.logger.Warn("Credit reservation already settled, skipping release", zap.Int64("reservation_id", reservationId))
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("Could not release credit reservation %d: %s", reservationId, err.Error())
+		}
+
+		subPlan, err := q.GetSubscriptionByTeamIdForUpdate(ctx, reservation.TeamID)
+		if err != nil {
+			return fmt.Errorf("Could not find subscription plan for team %d: %s", reservation.TeamID, err.Error())
+		}
+
+		_, err = q.SetCreditBalancesById(ctx, database.SetCreditBalancesByIdParams{
+			ID:                 subPlan.ID,
+			RemainingCredits:   subPlan.RemainingCredits + (reservation.ReservedCredits - reservation.ReservedOverageCredits),
+			OverageCreditsUsed: utils.Max64(0, subPlan.OverageCreditsUsed-reservation.ReservedOverageCredits),
+		})
+		if err != nil {
+			return fmt.Errorf("Could not return %d held credits to team %d: %s", reservation.ReservedCredits, reservation.TeamID, err.Error())
+		}
+
+		p.logger.Info(
+			"Released credit reservation",
+			zap.Int64("reservation_id", reservationId),
+			zap.Int64("team_id", reservation.TeamID),
+			zap.Int64("released_credits", reservation.ReservedCredits),
+			zap.Int64("released_overage_credits", reservation.ReservedOverageCredits),
+		)
+
+		return nil
+	})
+}
+
+func (p *Payments) ReleaseCreditsByTransformationId(ctx context.Context, transformationId int64) error {
+	reservation, err := p.database.GetCreditReservationByTransformationId(ctx, sql.NullInt64{Valid: true, Int64: transformationId})
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return p.ReleaseCredits(ctx, reservation.ID)
+}
+
+// getReservationStaleAge is how long a hold has to be open before it is
+// treated as orphaned. Other servers may still be running the jobs behind
+// younger holds, so it has to be longer than any job takes.
+func getReservationStaleAge() time.Duration {
+	hours, err := strconv.Atoi(os.Getenv("CREDIT_RESERVATION_STALE_HOURS"))
+	if err != nil || hours <= 0 {
+		hours = 24
+	}
+	return time.Duration(hours) * time.Hour
+}
+
+// RunReservationSettler settles orphaned holds and retries failed overage
+// reports now and then every hour until the context is cancelled.
+func (p *Payments) RunReservationSettler(ctx context.Context) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+
+	for {
+		p.SettleOrphanedReservations(ctx)
+		p.reportPendingOverage(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// SettleOrphanedReservations settles holds left behind by a crash. Dubbing
+// jobs run in goroutines inside an API server, so a hold that has been open
+// for longer than any job takes belongs to a job that died with its server.
+// Finished jobs are charged the full hold, everything else is marked as failed
+// and its credits are returned.
+func (p *Payments) SettleOrphanedReservations(ctx context.Context) {
+	reservations, err := p.database.GetStaleCreditReservations(ctx, time.Now().Add(-getReservationStaleAge()))
+	if err != nil {
+		p.logger.Error("Could not fetch held credit reservations", zap.Error(err))
+		return
+	}
+
+	for _, reservation := range reservations {
+		if reservation.TransformationID.Valid == false {
+			err = p.ReleaseCredits(ctx, reservation.ID)
+		} else {
+			transformation, tErr := p.database.GetTransformationById(ctx, reservation.TransformationID.Int64)
+			if tErr == nil && transformation.Status == "complete" {
+				err = p.CaptureCredits(ctx, reservation.ID, reservation.ReservedCredits)
+			} else {
+				if tErr == nil {
+					p.database.UpdateTransformationStatusById(ctx, database.UpdateTransformationStatusByIdParams{
+						ID:     transformation.ID,
+						Status: "error",
+					})
+				}
+				err = p.ReleaseCredits(ctx, reservation.ID)
+			}
+		}
+
+		if err != nil {
+			p.logger.Error("Could not settle orphaned credit reservation", zap.Error(err), zap.Int64("reservation_id", reservation.ID))
+		}
+	}
+
+	p.logger.Info("Settled orphaned credit reservations", zap.Int("reservations", len(reservations)))
+}
@@ -0,0 +1,283 @@
+package paymentsmiddleware
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"planetcastdev/database"
+	"planetcastdev/email"
+	"strconv"
+	"time"
+
+	"github.com/stripe/stripe-go/v76"
+	"github.com/stripe/stripe-go/v76/product"
+	"go.uber.org/zap"
+)
+
+const freePlanTier = "FREE"
+
+type planDetails struct {
+	PriceID         string
+	Tier            string
+	IncludedCredits int64
+}
+
+// getPlanDetails reads the plan tier and the credits included in one billing
+// period from the product behind the subscription's plan item. Yearly plans
+// include twelve months of credits.
+func (p *Payments) getPlanDetails(sub *stripe.Subscription) (planDetails, error) {
+	planItem := getPlanItem(sub)
+	if planItem == nil || planItem.Price == nil || planItem.Price.Product == nil {
+		return planDetails{}, fmt.Errorf("No items found for subscription ID %s", sub.ID)
+	}
+
+	prod, err := product.Get(planItem.Price.Product.ID, nil)
+	if err != nil {
+		return planDetails{}, fmt.Errorf("Could not fetch subscription %s product: %s", sub.ID, err.Error())
+	}
+
+	credits, ok := prod.Metadata["monthly_credits_included"]
+	if !ok {
+		return planDetails{}, fmt.Errorf("No credits included field in the product %s %s", prod.ID, prod.Name)
+	}
+
+	value, err := strconv.ParseInt(credits, 10, 64)
+	if err != nil {
+		return planDetails{}, fmt.Errorf(
+			"Unable to parse included credits string in product (%s %s) '%s': %s",
+			prod.ID, prod.Name, credits, err.Error())
+	}
+
+	if value <= 0 {
+		return planDetails{}, fmt.Errorf("Invalid amount of credits included (%d) in the subscription %s", value, sub.ID)
+	}
+
+	if planItem.Price.Recurring != nil && planItem.Price.Recurring.Interval == stripe.PriceRecurringIntervalYear {
+		value *= 12
+	}
+
+	tier, ok := prod.Metadata["tier"]
+	if !ok || tier == "" {
+		tier = prod.Name
+	}
+
+	return planDetails{PriceID: planItem.Price.ID, Tier: tier, IncludedCredits: value}, nil
+}
+
+// getGracePeriodDays returns how many days a past due team can keep starting
+// new dubbing jobs before they are paused.
+func getGracePeriodDays() int {
+	days, err := strconv.Atoi(os.Getenv("SUBSCRIPTION_GRACE_PERIOD_DAYS"))
+	if err != nil || days < 0 {
+		days = 7
+	}
+	return days
+}
+
+// isPastGracePeriod reports whether the team has been past due for longer
+// than the grace period, in which case new dubbing is paused.
+func isPastGracePeriod(subPlan database.SubscriptionPlan) bool {
+	if subPlan.PastDueSince.Valid == false {
+		return false
+	}
+	gracePeriod := time.Duration(getGracePeriodDays()) * 24 * time.Hour
+	return time.Since(subPlan.PastDueSince.Time) > gracePeriod
+}
+
+// prorateCredits returns the credits to add (or take away, if negative) when
+// a team moves between plans partway through a billing period. Only the
+// difference for the part of the period that is left is applied.
+func prorateCredits(sub *stripe.Subscription, oldCredits int64, newCredits int64) int64 {
+	periodLength := sub.CurrentPeriodEnd - sub.CurrentPeriodStart
+	if periodLength <= 0 {
+		return 0
+	}
+
+	remaining := sub.CurrentPeriodEnd - time.Now().Unix()
+	if remaining <= 0 {
+		return 0
+	}
+	if remaining > periodLength {
+		remaining = periodLength
+	}
+
+	return (newCredits - oldCredits) * remaining / periodLength
+}
+
+func (p *Payments) getTeamByCustomerId(ctx context.Context, customerId string) (database.Team, error) {
+	team, err := p.database.GetTeamByStripeCustomerId(ctx, sql.NullString{Valid: true, String: customerId})
+	if err != nil {
+		return database.Team{}, fmt.Errorf("Could not fetch team from DB using stripe customer ID %s: %s", customerId, err.Error())
+	}
+	return team, nil
+}
+
+// changeSubscriptionPlan stores the plan the team is subscribed to. If the
+// team moved their subscription from one price to another, their remaining
+// credits are prorated for the rest of the current period. A new subscription
+// is never prorated, its credits are granted by invoice.paid even when it
+// replaces an old one. Returns true if the plan changed.
+func (p *Payments) changeSubscriptionPlan(ctx context.Context, team database.Team, sub *stripe.Subscription, details planDetails, prorate bool) (bool, error) {
+	changed := false
+
+	err := p.database.ExecTx(ctx, func(q *database.Queries) error {
+		subPlan, err := q.GetSubscriptionByTeamIdForUpdate(ctx, team.ID)
+		if err != nil {
+			return fmt.Errorf("Could not find subscription plan for team %d: %s", team.ID, err.Error())
+		}
+
+		if subPlan.StripePriceID.Valid && subPlan.StripePriceID.String == details.PriceID {
+			return nil
+		}
+
+		if prorate && subPlan.StripePriceID.Valid && subPlan.StripeSubscriptionID.String == sub.ID {
+			delta := prorateCredits(sub, subPlan.IncludedCredits, details.IncludedCredits)
+			subPlan, err = q.AdjustSubscriptionCreditsByTeamId(ctx, database.AdjustSubscriptionCreditsByTeamIdParams{
+				TeamID:           team.ID,
+				RemainingCredits: delta,
+			})
+			if err != nil {
+				return fmt.Errorf("Unable to prorate %d credits for team %d: %s", delta, team.ID, err.Error())
+			}
+
+			p.logger.Info(
+				"Prorated credits for plan change",
+				zap.Int64("team_id", team.ID),
+				zap.String("old_price_id", subPlan.StripePriceID.String),
+				zap.String("new_price_id", details.PriceID),
+				zap.Int64("credits_delta", delta),
+				zap.Int64("new_credit_amount", subPlan.RemainingCredits),
+			)
+			changed = true
+		}
+
+		_, err = q.SetSubscriptionPlanTierByTeamId(ctx, database.SetSubscriptionPlanTierByTeamIdParams{
+			TeamID:          team.ID,
+			StripePriceID:   sql.NullString{Valid: true, String: details.PriceID},
+			PlanTier:        details.Tier,
+			IncludedCredits: details.IncludedCredits,
+		})
+		if err != nil {
+			return fmt.Errorf("Unable to update plan tier for team %d: %s", team.ID, err.Error())
+		}
+
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if changed {
+		p.notifySubscriptionChange(team, details.Tier, p.email.SubscriptionChangedAlert)
+	}
+
+	return changed, nil
+}
+
+// transitionSubscriptionStatus moves the team's plan to a new Stripe status
+// and emails the team about it. Stripe sends several events for the same
+// change (e.g. invoice.payment_failed and customer.subscription.updated), so
+// a transition to the status the plan already has is a no-op.
+func (p *Payments) transitionSubscriptionStatus(ctx context.Context, team database.Team, status stripe.SubscriptionStatus) error {
+	var previousStatus string
+	var subPlan database.SubscriptionPlan
+
+	err := p.database.ExecTx(ctx, func(q *database.Queries) error {
+		var err error
+		subPlan, err = q.GetSubscriptionByTeamIdForUpdate(ctx, team.ID)
+		if err != nil {
+			return fmt.Errorf("Could not find subscription plan for team %d: %s", team.ID, err.Error())
+		}
+
+		previousStatus = subPlan.SubscriptionStatus
+		if previousStatus == string(status) {
+			return nil
+		}
+
+		pastDueSince := sql.NullTime{Valid: false}
+		if status == stripe.SubscriptionStatusPastDue || status == stripe.SubscriptionStatusUnpaid {
+			pastDueSince = subPlan.PastDueSince
+			if pastDueSince.Valid == false {
+				pastDueSince = sql.NullTime{Valid: true, Time: time.Now().UTC()}
+			}
+		}
+
+		subPlan, err = q.SetSubscriptionStatusByTeamId(ctx, database.SetSubscriptionStatusByTeamIdParams{
+			TeamID:             team.ID,
+			SubscriptionStatus: string(status),
+			PastDueSince:       pastDueSince,
+		})
+		if err != nil {
+			return fmt.Errorf("Unable to update subscription status for team %d: %s", team.ID, err.Error())
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if previousStatus == string(status) {
+		return nil
+	}
+
+	p.logger.Info(
+		"Subscription status changed",
+		zap.Int64("team_id", team.ID),
+		zap.String("previous_status", previousStatus),
+		zap.String("status", string(status)),
+	)
+
+	wasPastDue := previousStatus == string(stripe.SubscriptionStatusPastDue) || previousStatus == string(stripe.SubscriptionStatusUnpaid)
+
+	switch status {
+	case stripe.SubscriptionStatusActive, stripe.SubscriptionStatusTrialing:
+		if wasPastDue {
+			p.notifySubscriptionChange(team, subPlan.PlanTier, p.email.SubscriptionReactivatedAlert)
+		} else {
+			p.notifySubscriptionChange(team, subPlan.PlanTier, p.email.SubscriptionStartedAlert)
+		}
+	case stripe.SubscriptionStatusPastDue:
+		p.notifySubscriptionChange(team, subPlan.PlanTier, p.email.SubscriptionPaymentFailedAlert)
+	case stripe.SubscriptionStatusCanceled:
+		p.notifySubscriptionChange(team, subPlan.PlanTier, p.email.SubscriptionCanceledAlert)
+	}
+
+	return nil
+}
+
+// notifySubscriptionChange sends a subscription email to the team's billing
+// address on Stripe. While a webhook event is being handled the email is held
+// back until the event's transaction commits.
+func (p *Payments) notifySubscriptionChange(team database.Team, planTier string, alert func(email.SubscriptionAlertProps)) {
+	if team.StripeCustomerID.Valid == false {
+		return
+	}
+
+	customer, err := p.getCustomer(team.StripeCustomerID.String)
+	if err != nil || customer.Email == "" {
+		p.logger.Error(
+			"Could not fetch billing email for subscription notification",
+			zap.Int64("team_id", team.ID),
+			zap.String("stripe_customer_id", team.StripeCustomerID.String),
+		)
+		return
+	}
+
+	send := func() {
+		alert(email.SubscriptionAlertProps{
+			PlanTier:        planTier,
+			TeamSlug:        team.Slug,
+			UserEmail:       customer.Email,
+			GracePeriodDays: getGracePeriodDays(),
+		})
+	}
+
+	if p.pendingAlerts != nil {
+		*p.pendingAlerts = append(*p.pendingAlerts, send)
+		return
+	}
+
+	go send()
+}
@@ -7,6 +7,7 @@ import (
 	"os"
 	"planetcastdev/auth"
 	"planetcastdev/database"
+	"planetcastdev/email"
 	"planetcastdev/graph/model"
 	"time"
 
@@ -22,17 +23,32 @@ type Payments struct {
 	secretKey string
 	database  *database.Queries
 	logger    *zap.Logger
+	email     *email.Email
+
+	// pendingAlerts collects emails while a webhook event is being handled
+	// inside a transaction, they are sent once it commits
+	pendingAlerts *[]func()
 }
 
 type PaymentsConnectProps struct {
 	Logger   *zap.Logger
 	Database *database.Queries
+	Email    *email.Email
 }
 
 func Connect(args PaymentsConnectProps) *Payments {
 	STRIPE_KEY := os.Getenv("STRIPE_SECRET_KEY")
 	stripe.Key = STRIPE_KEY
-	return &Payments{secretKey: STRIPE_KEY, database: args.Database, logger: args.Logger}
+	return &Payments{secretKey: STRIPE_KEY, database: args.Database, logger: args.Logger, email: args.Email}
+}
+
+// withQueries returns a copy of Payments that runs all of its queries on q.
+// Used to handle a webhook event inside a single transaction.
+func (p *Payments) withQueries(q *database.Queries) *Payments {
+	txPayments := *p
+	txPayments.database = q
+	txPayments.pendingAlerts = &[]func(){}
+	return &txPayments
 }
 
 // Customer Management
@@ -147,11 +163,11 @@ func (p *Payments) GetSubscriptionProduct(subscriptionId string) (*stripe.Produc
 		return nil, err
 	}
 
-	if len(sub.Items.Data) <= 0 {
+	firstItem := getPlanItem(sub)
+	if firstItem == nil {
 		return nil, fmt.Errorf("No items found for subscription ID %s", subscriptionId)
 	}
 
-	firstItem := sub.Items.Data[0]
 	productObject, err := product.Get(firstItem.Price.Product.ID, nil)
 	if err != nil {
 		return nil, err
@@ -167,11 +183,11 @@ func (p *Payments) GetSubscriptionAmountInUSDAndInterval(subscriptionId string)
 		return 0, "", err
 	}
 
-	if len(sub.Items.Data) <= 0 {
+	firstItem := getPlanItem(sub)
+	if firstItem == nil {
 		return 0, "", fmt.Errorf("No items found for subscription ID %s", subscriptionId)
 	}
 
-	firstItem := sub.Items.Data[0]
 	interval := string(firstItem.Plan.Interval)
 
 	// This assumes that firstItem.Plan.Currency will be USD
@@ -183,6 +199,21 @@ func (p *Payments) GetSubscriptionAmountInUSDAndInterval(subscriptionId string)
 	return amount / 100, interval, nil
 }
 
+// getPlanItem returns the licensed item that represents the plan itself,
+// skipping the metered overage item if the subscription has one.
+func getPlanItem(sub *stripe.Subscription) *stripe.SubscriptionItem {
+	if sub.Items == nil {
+		return nil
+	}
+	for _, item := range sub.Items.Data {
+		if item.Price != nil && item.Price.Recurring != nil && item.Price.Recurring.UsageType == stripe.PriceRecurringUsageTypeMetered {
+			continue
+		}
+		return item
+	}
+	return nil
+}
+
 func (p *Payments) CancelSubscription(subscriptionId string) (*stripe.Subscription, error) {
 	params := &stripe.SubscriptionCancelParams{
 		InvoiceNow: stripe.Bool(true),
@@ -210,11 +241,11 @@ func (p *Payments) GetSubscriptionInterval(subscriptionId string) (stripe.PlanIn
 		return "", err
 	}
 
-	if err != nil {
+	firstItem := getPlanItem(subPlan)
+	if firstItem == nil {
 		return "", fmt.Errorf("No items found for subscription ID %s", subscriptionId)
 	}
 
-	firstItem := subPlan.Items.Data[0]
 	interval := firstItem.Plan.Interval
 
 	return interval, nil
@@ -253,11 +284,11 @@ func (p *Payments) GetSubscriptionPlanData(subscriptionId string) (*model.Subscr
 		return nil, err
 	}
 
-	if len(subPlan.Items.Data) <= 0 {
+	firstItem := getPlanItem(subPlan)
+	if firstItem == nil {
 		return nil, fmt.Errorf("No items found for subscription ID %s", subscriptionId)
 	}
 
-	firstItem := subPlan.Items.Data[0]
 	currentPeriodStart := time.Unix(subPlan.CurrentPeriodStart, 0).UTC()
 	currentPeriodEnd := time.Unix(subPlan.CurrentPeriodEnd, 0).UTC()
 	status := string(subPlan.Status)
@@ -0,0 +1,191 @@
+package paymentsmiddleware
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"planetcastdev/database"
+	"time"
+
+	"github.com/stripe/stripe-go/v76"
+	"github.com/stripe/stripe-go/v76/price"
+	"github.com/stripe/stripe-go/v76/subscriptionitem"
+	"github.com/stripe/stripe-go/v76/usagerecord"
+	"go.uber.org/zap"
+)
+
+// getMeteredItem returns the subscription item that overage minutes are
+// reported against, or nil if the subscription does not have one.
+func getMeteredItem(sub *stripe.Subscription) *stripe.SubscriptionItem {
+	if sub.Items == nil {
+		return nil
+	}
+	for _, item := range sub.Items.Data {
+		if item.Price != nil && item.Price.Recurring != nil && item.Price.Recurring.UsageType == stripe.PriceRecurringUsageTypeMetered {
+			return item
+		}
+	}
+	return nil
+}
+
+func getOveragePriceLookUpKey() string {
+	lookUpKey := os.Getenv("STRIPE_OVERAGE_PRICE_LOOKUP_KEY")
+	if lookUpKey == "" {
+		lookUpKey = "overage_minutes"
+	}
+	return lookUpKey
+}
+
+func (p *Payments) getOveragePrice() (*stripe.Price, error) {
+	params := &stripe.PriceListParams{
+		LookupKeys: stripe.StringSlice([]string{getOveragePriceLookUpKey()}),
+	}
+
+	var overagePrice *stripe.Price
+	i := price.List(params)
+	for i.Next() {
+		overagePrice = i.Price()
+	}
+
+	if i.Err() != nil {
+		return nil, i.Err()
+	}
+
+	if overagePrice == nil {
+		return nil, fmt.Errorf("No overage price found with look up key %s", getOveragePriceLookUpKey())
+	}
+
+	return overagePrice, nil
+}
+
+type SetOverageBillingProps struct {
+	TeamSlug    string
+	Enabled     bool
+	SpendCapUsd int64
+}
+
+// SetOverageBilling opts a team in or out of overage billing. Opting in adds
+// the metered overage price to the team's Stripe subscription if it is not
+// already there.
+func (p *Payments) SetOverageBilling(ctx context.Context, args SetOverageBillingProps) (database.SubscriptionPlan, error) {
+	team, err := p.database.GetTeamBySlug(ctx, args.TeamSlug)
+	if err != nil {
+		return database.SubscriptionPlan{}, fmt.Errorf("Could not find team %s: %s", args.TeamSlug, err.Error())
+	}
+
+	if args.Enabled {
+		if args.SpendCapUsd <= 0 {
+			return database.SubscriptionPlan{}, fmt.Errorf("Overage spend cap must be greater than 0")
+		}
+
+		subs, err := p.database.GetSubscriptionsByTeamId(ctx, team.ID)
+		if err != nil || len(subs) == 0 || subs[0].StripeSubscriptionID.Valid == false {
+			return database.SubscriptionPlan{}, fmt.Errorf("An active subscription is required to enable overage billing")
+		}
+
+		subscriptionId := subs[0].StripeSubscriptionID.String
+		sub, err := p.GetSubscription(subscriptionId)
+		if err != nil {
+			return database.SubscriptionPlan{}, fmt.Errorf("Could not fetch subscription %s: %s", subscriptionId, err.Error())
+		}
+
+		if getMeteredItem(sub) == nil {
+			overagePrice, err := p.getOveragePrice()
+			if err != nil {
+				return database.SubscriptionPlan{}, err
+			}
+
+			_, err = subscriptionitem.New(&stripe.SubscriptionItemParams{
+				Subscription: stripe.String(subscriptionId),
+				Price:        stripe.String(overagePrice.ID),
+			})
+			if err != nil {
+				return database.SubscriptionPlan{}, fmt.Errorf("Could not add overage item to subscription %s: %s", subscriptionId, err.Error())
+			}
+		}
+	}
+
+	subPlan, err := p.database.SetOverageSettingsByTeamId(ctx, database.SetOverageSettingsByTeamIdParams{
+		TeamID:             team.ID,
+		OverageEnabled:     args.Enabled,
+		OverageSpendCapUsd: args.SpendCapUsd,
+	})
+	if err != nil {
+		return database.SubscriptionPlan{}, fmt.Errorf("Could not update overage settings for team %d: %s", team.ID, err.Error())
+	}
+
+	p.logger.Info(
+		"Updated overage billing settings",
+		zap.Int64("team_id", team.ID),
+		zap.Bool("overage_enabled", args.Enabled),
+		zap.Int64("overage_spend_cap_usd", args.SpendCapUsd),
+	)
+
+	return subPlan, nil
+}
+
+// getOverageCreditCap converts the team's spend cap into the number of
+// overage credits that fit under it at the metered price.
+func (p *Payments) getOverageCreditCap(subPlan database.SubscriptionPlan) (int64, error) {
+	if subPlan.StripeSubscriptionID.Valid == false {
+		return 0, fmt.Errorf("No active subscription for team %d", subPlan.TeamID)
+	}
+
+	sub, err := p.GetSubscription(subPlan.StripeSubscriptionID.String)
+	if err != nil {
+		return 0, err
+	}
+
+	meteredItem := getMeteredItem(sub)
+	if meteredItem == nil {
+		return 0, fmt.Errorf("No overage item found on subscription %s", sub.ID)
+	}
+
+	unitAmount := meteredItem.Price.UnitAmount
+	if unitAmount <= 0 {
+		return 0, fmt.Errorf("Invalid overage price (%d) on subscription %s", unitAmount, sub.ID)
+	}
+
+	return (subPlan.OverageSpendCapUsd * 100) / unitAmount, nil
+}
+
+// reportOverageUsage records overage credits on the metered item so Stripe
+// bills them on the next invoice. The reservation id is used as the
+// idempotency key so a retried capture is never billed twice.
+func (p *Payments) reportOverageUsage(subPlan database.SubscriptionPlan, reservationId int64, credits int64) error {
+	if subPlan.StripeSubscriptionID.Valid == false {
+		return fmt.Errorf("No active subscription for team %d", subPlan.TeamID)
+	}
+
+	sub, err := p.GetSubscription(subPlan.StripeSubscriptionID.String)
+	if err != nil {
+		return err
+	}
+
+	meteredItem := getMeteredItem(sub)
+	if meteredItem == nil {
+		return fmt.Errorf("No overage item found on subscription %s", sub.ID)
+	}
+
+	params := &stripe.UsageRecordParams{
+		SubscriptionItem: stripe.String(meteredItem.ID),
+		Quantity:         stripe.Int64(credits),
+		Timestamp:        stripe.Int64(time.Now().Unix()),
+		Action:           stripe.String(stripe.UsageRecordActionIncrement),
+	}
+	params.SetIdempotencyKey(fmt.Sprintf("overage-reservation-%d", reservationId))
+
+	_, err = usagerecord.New(params)
+	if err != nil {
+		return err
+	}
+
+	p.logger.Info(
+		"Reported overage usage to stripe",
+		zap.Int64("team_id", subPlan.TeamID),
+		zap.Int64("reservation_id", reservationId),
+		zap.Int64("overage_credits", credits),
+	)
+
+	return nil
+}
@@ -41,84 +41,242 @@ func (p *Payments) HandleStripeWebhook(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	err = p.database.CreateStripeEvent(ctx, database.CreateStripeEventParams{
+		StripeEventID: event.ID,
+		EventType:     string(event.Type),
+		Payload:       string(payload),
+	})
+	if err != nil {
+		p.RespondWithError(w, http.StatusInternalServerError, "Error storing stripe event "+err.Error())
+		return
+	}
+
+	_, err = p.processEvent(ctx, event)
+	if err != nil {
+		p.RespondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+}
+
+// processEvent runs the handler for a stored event exactly once. The event row
+// is locked for the duration of the handler so concurrent deliveries of the
+// same event wait for each other, and the handler's writes are committed
+// together with the event being marked as processed. Anything the handler
+// needs from Stripe is fetched before the lock is taken. Failed events keep
+// their error so they can be replayed with ReplayStripeEvent.
+func (p *Payments) processEvent(ctx context.Context, event stripe.Event) (database.StripeEvent, error) {
+	var storedEvent database.StripeEvent
+	var alerts []func()
+
+	data := p.fetchStripeData(event)
+
+	err := p.database.ExecTx(ctx, func(q *database.Queries) error {
+		var err error
+		storedEvent, err = q.GetStripeEventByStripeEventIdForUpdate(ctx, event.ID)
+		if err != nil {
+			return fmt.Errorf("Could not find stripe event %s: %s", event.ID, err.Error())
+		}
+
+		if storedEvent.Status == database.StripeEventStatusPROCESSED || storedEvent.Status == database.StripeEventStatusIGNORED {
+			p.logger.Info("Stripe event already processed, skipping", zap.String("event_id", event.ID), zap.String("event_type", string(event.Type)))
+			return nil
+		}
+
+		txPayments := p.withQueries(q)
+		handled, err := txPayments.dispatchEvent(ctx, event, data)
+		if err != nil {
+			return err
+		}
+		alerts = *txPayments.pendingAlerts
+
+		status := database.StripeEventStatusPROCESSED
+		if handled == false {
+			p.logger.Info("Stripe event type not handled, acknowledging", zap.String("event_id", event.ID), zap.String("event_type", string(event.Type)))
+			status = database.StripeEventStatusIGNORED
+		}
+
+		storedEvent, err = q.SetStripeEventProcessedById(ctx, database.SetStripeEventProcessedByIdParams{
+			ID:     storedEvent.ID,
+			Status: status,
+		})
+		if err != nil {
+			return fmt.Errorf("Could not mark stripe event %s as processed: %s", event.ID, err.Error())
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		failedEvent, failErr := p.database.SetStripeEventFailedById(ctx, database.SetStripeEventFailedByIdParams{
+			ID:        storedEvent.ID,
+			LastError: sql.NullString{Valid: true, String: err.Error()},
+		})
+		if failErr != nil {
+			p.logger.Error("Could not mark stripe event as failed", zap.Error(failErr), zap.String("event_id", event.ID))
+			return storedEvent, err
+		}
+		return failedEvent, err
+	}
+
+	// Emails only go out once the changes they describe are committed
+	for _, alert := range alerts {
+		go alert()
+	}
+
+	return storedEvent, nil
+}
+
+// ReplayStripeEvent processes a stored event again. Only events that have
+// not been processed successfully can be replayed.
+func (p *Payments) ReplayStripeEvent(ctx context.Context, stripeEventId string) (database.StripeEvent, error) {
+	storedEvent, err := p.database.GetStripeEventByStripeEventId(ctx, stripeEventId)
+	if err != nil {
+		return database.StripeEvent{}, fmt.Errorf("Could not find stripe event %s: %s", stripeEventId, err.Error())
+	}
+
+	if storedEvent.Status == database.StripeEventStatusPROCESSED || storedEvent.Status == database.StripeEventStatusIGNORED {
+		return database.StripeEvent{}, fmt.Errorf("Stripe event %s has already been processed", stripeEventId)
+	}
+
+	event := stripe.Event{}
+	if err := json.Unmarshal([]byte(storedEvent.Payload), &event); err != nil {
+		return database.StripeEvent{}, fmt.Errorf("Could not parse stored stripe event %s: %s", stripeEventId, err.Error())
+	}
+
+	p.logger.Info("Replaying stripe event", zap.String("event_id", event.ID), zap.String("event_type", string(event.Type)), zap.Int64("attempts", storedEvent.Attempts))
+
+	return p.processEvent(ctx, event)
+}
+
+func (p *Payments) GetFailedStripeEvents(ctx context.Context) ([]database.StripeEvent, error) {
+	return p.database.GetStripeEventsByStatus(ctx, database.StripeEventStatusFAILED)
+}
+
+// stripeData is what an event's handler needs from Stripe's API. It is
+// fetched before the event's transaction opens, so no row stays locked while
+// Stripe responds. A failed fetch is returned by the handler, an event that
+// was already processed is not marked as failed because of it.
+type stripeData struct {
+	plan planDetails
+	err  error
+}
+
+// grantsPlanCredits reports whether a paid invoice starts a billing period.
+// Plan changes are charged with subscription_update invoices, their credits
+// were already prorated.
+func grantsPlanCredits(invoice *stripe.Invoice) bool {
+	return invoice.BillingReason == stripe.InvoiceBillingReasonSubscriptionCreate || invoice.BillingReason == stripe.InvoiceBillingReasonSubscriptionCycle
+}
+
+// fetchStripeData looks up the plan behind subscription events and paid
+// subscription invoices. Events that fail to parse are left to their handler.
+func (p *Payments) fetchStripeData(event stripe.Event) stripeData {
+	switch event.Type {
+
+	case "customer.subscription.created", "customer.subscription.updated":
+		subscription, err := p.parseSubscriptionBody(event.Data.Raw)
+		if err != nil {
+			return stripeData{}
+		}
+		details, err := p.getPlanDetails(subscription)
+		return stripeData{plan: details, err: err}
+
+	case "invoice.paid":
+		invoice, err := p.parseInvoiceBody(event.Data.Raw)
+		if err != nil || invoice.Subscription == nil || grantsPlanCredits(invoice) == false {
+			return stripeData{}
+		}
+		sub, err := p.GetSubscription(invoice.Subscription.ID)
+		if err != nil {
+			return stripeData{err: fmt.Errorf("Could not fetch subscription %s: %s", invoice.Subscription.ID, err.Error())}
+		}
+		details, err := p.getPlanDetails(sub)
+		return stripeData{plan: details, err: err}
+	}
+
+	return stripeData{}
+}
+
+// dispatchEvent routes an event to its handler. Returns false for event types
+// there is no handler for.
+func (p *Payments) dispatchEvent(ctx context.Context, event stripe.Event, data stripeData) (bool, error) {
 	switch event.Type {
 
 	case "customer.deleted":
 		customer, err := p.parseCustomerBody(event.Data.Raw)
 		if err != nil {
-			p.RespondWithError(w, http.StatusInternalServerError, "Error parsing customer data "+err.Error())
-			return
+			return true, fmt.Errorf("Error parsing customer data: %s", err.Error())
 		}
 		err = p.DeleteCustomerFromDB(ctx, customer.ID)
 		if err != nil {
-			p.RespondWithError(w, http.StatusInternalServerError, "Error handling customer deletion "+err.Error())
-			return
+			return true, fmt.Errorf("Error handling customer deletion: %s", err.Error())
 		}
 
 	case "customer.subscription.deleted":
 		subscription, err := p.parseSubscriptionBody(event.Data.Raw)
 		if err != nil {
-			p.RespondWithError(w, http.StatusInternalServerError, "Error parsing subscription data "+err.Error())
-			return
+			return true, fmt.Errorf("Error parsing subscription data: %s", err.Error())
 		}
 		err = p.handleSubscriptionDeleted(ctx, *subscription)
 		if err != nil {
-			p.RespondWithError(w, http.StatusInternalServerError, "Error handling subscription deletion "+err.Error())
-			return
+			return true, fmt.Errorf("Error handling subscription deletion: %s", err.Error())
 		}
 
 	case "customer.subscription.updated":
 		subscription, err := p.parseSubscriptionBody(event.Data.Raw)
 		if err != nil {
-			p.RespondWithError(w, http.StatusInternalServerError, "Error parsing subscription data "+err.Error())
-			return
+			return true, fmt.Errorf("Error parsing subscription data: %s", err.Error())
 		}
-		err = p.handleSubscriptionUpdated(ctx, *subscription)
+		err = p.handleSubscriptionUpdated(ctx, *subscription, data)
 		if err != nil {
-			p.RespondWithError(w, http.StatusInternalServerError, "Error handling subscription update "+err.Error())
-			return
+			return true, fmt.Errorf("Error handling subscription update: %s", err.Error())
 		}
 
 	case "customer.subscription.created":
 		subscription, err := p.parseSubscriptionBody(event.Data.Raw)
 		if err != nil {
-			p.RespondWithError(w, http.StatusInternalServerError, "Error parsing subscription data "+err.Error())
-			return
+			return true, fmt.Errorf("Error parsing subscription data: %s", err.Error())
+		}
+		err = p.handleSubscriptionCreated(ctx, *subscription, data)
+		if err != nil {
+			return true, fmt.Errorf("Error handling subscription creation: %s", err.Error())
 		}
-		err = p.handleSubscriptionCreated(ctx, *subscription)
+
+	case "checkout.session.completed", "checkout.session.async_payment_succeeded":
+		session, err := p.parseCheckoutSessionBody(event.Data.Raw)
+		if err != nil {
+			return true, fmt.Errorf("Error parsing checkout session data: %s", err.Error())
+		}
+		err = p.handleCheckoutSessionCompleted(ctx, *session)
 		if err != nil {
-			p.RespondWithError(w, http.StatusInternalServerError, "Error handling subscription creation "+err.Error())
-			return
+			return true, fmt.Errorf("Error handling checkout session completed event: %s", err.Error())
 		}
 
 	case "invoice.paid":
 		invoice, err := p.parseInvoiceBody(event.Data.Raw)
 		if err != nil {
-			p.RespondWithError(w, http.StatusInternalServerError, "Error parsing invoice data "+err.Error())
-			return
+			return true, fmt.Errorf("Error parsing invoice data: %s", err.Error())
 		}
-		err = p.handleInvoicePaid(ctx, *invoice)
+		err = p.handleInvoicePaid(ctx, *invoice, data)
 		if err != nil {
-			p.RespondWithError(w, http.StatusInternalServerError, "Error handling invoide paid event "+err.Error())
-			return
+			return true, fmt.Errorf("Error handling invoide paid event: %s", err.Error())
 		}
 
 	case "invoice.payment_failed":
 		invoice, err := p.parseInvoiceBody(event.Data.Raw)
 		if err != nil {
-			p.RespondWithError(w, http.StatusInternalServerError, "Error parsing invoice data "+err.Error())
-			return
+			return true, fmt.Errorf("Error parsing invoice data: %s", err.Error())
 		}
 		err = p.handleInvoicePaymentFailed(ctx, *invoice)
 		if err != nil {
-			p.RespondWithError(w, http.StatusInternalServerError, "Error handling invoide payment failed event "+err.Error())
-			return
+			return true, fmt.Errorf("Error handling invoide payment failed event: %s", err.Error())
 		}
 
 	default:
-		p.RespondWithError(w, http.StatusNotFound, "Event type not handled: "+string(event.Type))
-		return
+		return false, nil
 	}
+
+	return true, nil
 }
 
 func (p *Payments) parseCustomerBody(jsonMessage json.RawMessage) (*stripe.Customer, error) {
@@ -139,6 +297,15 @@ func (p *Payments) parseInvoiceBody(jsonMessage json.RawMessage) (*stripe.Invoic
 	return &invoice, nil
 }
 
+func (p *Payments) parseCheckoutSessionBody(jsonMessage json.RawMessage) (*stripe.CheckoutSession, error) {
+	var session stripe.CheckoutSession
+	err := json.Unmarshal(jsonMessage, &session)
+	if err != nil {
+		return nil, fmt.Errorf("Could not parse checkout session data: %s", err.Error())
+	}
+	return &session, nil
+}
+
 func (p *Payments) parseSubscriptionBody(jsonMessage json.RawMessage) (*stripe.Subscription, error) {
 	var subscription stripe.Subscription
 	err := json.Unmarshal(jsonMessage, &subscription)
@@ -153,6 +320,10 @@ func (p *Payments) handleSubscriptionDeleted(ctx context.Context, subscription s
 	p.logCustomer(customerId, "Customer Subscription Deleted")
 
 	sub_plan, err := p.database.GetSubscriptionByStripeSubscriptionId(ctx, sql.NullString{Valid: true, String: subscription.ID})
+	if err == sql.ErrNoRows {
+		p.logger.Info("Deleted subscription is not the active subscription of any team", zap.String("subscription_id", subscription.ID))
+		return nil
+	}
 	if err != nil {
 		return fmt.Errorf("Could not find active subscription with id %s: %s", subscription.ID, err.Error())
 	}
@@ -164,6 +335,11 @@ func (p *Payments) handleSubscriptionDeleted(ctx context.Context, subscription s
 		return fmt.Errorf("Could not fetch team from DB using stripe customer ID %s: %s", customerId, err.Error())
 	}
 
+	err = p.transitionSubscriptionStatus(ctx, team, stripe.SubscriptionStatusCanceled)
+	if err != nil {
+		return err
+	}
+
 	_, err = p.database.SetSubscriptionStripeIdByTeamId(ctx, database.SetSubscriptionStripeIdByTeamIdParams{
 		TeamID:               teamId,
 		StripeSubscriptionID: sql.NullString{Valid: false, String: ""},
@@ -173,6 +349,17 @@ func (p *Payments) handleSubscriptionDeleted(ctx context.Context, subscription s
 		return fmt.Errorf("Unable to update stripe subscription id for team: %d: %s", teamId, err.Error())
 	}
 
+	_, err = p.database.SetSubscriptionPlanTierByTeamId(ctx, database.SetSubscriptionPlanTierByTeamIdParams{
+		TeamID:          teamId,
+		StripePriceID:   sql.NullString{Valid: false, String: ""},
+		PlanTier:        freePlanTier,
+		IncludedCredits: 0,
+	})
+
+	if err != nil {
+		return fmt.Errorf("Unable to reset plan tier for team: %d: %s", teamId, err.Error())
+	}
+
 	p.logger.Info(
 		"Set subscription to unactive due to subscription deletion by team",
 		zap.String("team_name", team.Name),
@@ -182,58 +369,157 @@ func (p *Payments) handleSubscriptionDeleted(ctx context.Context, subscription s
 	return nil
 }
 
-func (p *Payments) handleSubscriptionCreated(ctx context.Context, subscription stripe.Subscription) error {
+func (p *Payments) handleSubscriptionCreated(ctx context.Context, subscription stripe.Subscription, data stripeData) error {
 	customerId := subscription.Customer.ID
 	p.logCustomer(customerId, "Customer Subscription Created")
-	return nil
+
+	team, err := p.getTeamByCustomerId(ctx, customerId)
+	if err != nil {
+		return err
+	}
+
+	_, err = p.database.SetSubscriptionStripeIdByTeamId(ctx, database.SetSubscriptionStripeIdByTeamIdParams{
+		TeamID:               team.ID,
+		StripeSubscriptionID: sql.NullString{Valid: true, String: subscription.ID},
+	})
+
+	if err != nil {
+		return fmt.Errorf("Unable to update stripe subscription id for team: %d: %s", team.ID, err.Error())
+	}
+
+	if data.err != nil {
+		return data.err
+	}
+
+	_, err = p.changeSubscriptionPlan(ctx, team, &subscription, data.plan, false)
+	if err != nil {
+		return err
+	}
+
+	return p.transitionSubscriptionStatus(ctx, team, subscription.Status)
 }
 
-func (p *Payments) handleSubscriptionUpdated(ctx context.Context, subscription stripe.Subscription) error {
+func (p *Payments) handleSubscriptionUpdated(ctx context.Context, subscription stripe.Subscription, data stripeData) error {
 	customerId := subscription.Customer.ID
 	p.logCustomer(customerId, "Customer Subscription Updated")
-	return nil
+
+	team, err := p.getTeamByCustomerId(ctx, customerId)
+	if err != nil {
+		return err
+	}
+
+	subs, err := p.database.GetSubscriptionsByTeamId(ctx, team.ID)
+	if err != nil || len(subs) == 0 {
+		return fmt.Errorf("Could not find subscription plan for team %d", team.ID)
+	}
+
+	// Events for a subscription the team has since replaced must not touch the current plan
+	if subs[0].StripeSubscriptionID.Valid && subs[0].StripeSubscriptionID.String != subscription.ID {
+		p.logger.Info(
+			"Ignoring update for subscription that is not the active subscription of the team",
+			zap.String("subscription_id", subscription.ID),
+			zap.Int64("team_id", team.ID),
+		)
+		return nil
+	}
+
+	if data.err != nil {
+		return data.err
+	}
+
+	_, err = p.changeSubscriptionPlan(ctx, team, &subscription, data.plan, true)
+	if err != nil {
+		return err
+	}
+
+	return p.transitionSubscriptionStatus(ctx, team, subscription.Status)
 }
 
-func (p *Payments) handleInvoicePaid(ctx context.Context, invoice stripe.Invoice) error {
+func (p *Payments) handleInvoicePaid(ctx context.Context, invoice stripe.Invoice, data stripeData) error {
 	customerId := invoice.Customer.ID
 	p.logCustomer(customerId, "Subscription Invoice Paid")
 
-	team, err := p.database.GetTeamByStripeCustomerId(ctx, sql.NullString{Valid: true, String: customerId})
+	team, err := p.getTeamByCustomerId(ctx, customerId)
 	if err != nil {
-		return fmt.Errorf("Could not fetch team from DB using stripe customer ID %s: %s", customerId, err.Error())
+		return err
+	}
+
+	if invoice.Subscription == nil {
+		p.logger.Info("Invoice is not for a subscription, no credits to grant", zap.String("invoice_id", invoice.ID))
+		return nil
 	}
 
 	subscriptionId := invoice.Subscription.ID
 
+	sub_plan, err := p.database.SetSubscriptionStripeIdByTeamId(ctx, database.SetSubscriptionStripeIdByTeamIdParams{
+		TeamID:               team.ID,
+		StripeSubscriptionID: sql.NullString{Valid: true, String: subscriptionId},
+	})
+
 	if err != nil {
-		return fmt.Errorf("Could not find subscription id %s: %s", subscriptionId, err.Error())
+		return fmt.Errorf("Unable to update stripe subscription id for team: %d: %s", team.ID, err.Error())
 	}
 
-	prod, err := p.GetSubscriptionProduct(subscriptionId)
-	if err != nil {
-		return fmt.Errorf("Could not fetch subscription %s products: %s", subscriptionId, err.Error())
+	if grantsPlanCredits(&invoice) {
+		if data.err != nil {
+			return data.err
+		}
+		details := data.plan
+
+		sub_plan, err = p.database.AddSubscriptionCreditsByTeamId(ctx, database.AddSubscriptionCreditsByTeamIdParams{
+			TeamID:           team.ID,
+			RemainingCredits: details.IncludedCredits,
+		})
+
+		if err != nil {
+			return fmt.Errorf("Unable to add %d credits to team %d: %s", details.IncludedCredits, team.ID, err.Error())
+		}
+
+		// A paid invoice starts a new billing period, overage usage counts towards the spend cap from zero again
+		sub_plan, err = p.database.ResetOverageCreditsUsedByTeamId(ctx, team.ID)
+		if err != nil {
+			return fmt.Errorf("Unable to reset overage credits for team: %d: %s", team.ID, err.Error())
+		}
+
+		p.logger.Info(
+			"Successfully granted credits to team",
+			zap.Int64("credits_added", details.IncludedCredits),
+			zap.Int64("new_credit_amount", sub_plan.RemainingCredits),
+			zap.String("team_name", team.Name),
+			zap.Int64("team_id", team.ID),
+		)
 	}
 
-	credits, ok := prod.Metadata["monthly_credits_included"]
-	if !ok {
-		return fmt.Errorf("No credits included field in the product %s %s", prod.ID, prod.Name)
+	return p.transitionSubscriptionStatus(ctx, team, stripe.SubscriptionStatusActive)
+}
+
+func (p *Payments) handleCheckoutSessionCompleted(ctx context.Context, session stripe.CheckoutSession) error {
+	// Subscription checkouts are granted credits through invoice.paid
+	if session.Mode != stripe.CheckoutSessionModePayment {
+		return nil
 	}
 
-	value, err := strconv.Atoi(credits)
-	if err != nil {
-		return fmt.Errorf(
-			"Unable to parse included credits string in product (%s %s) '%s': %s",
-			prod.ID, prod.Name, credits, err.Error())
+	if session.PaymentStatus != stripe.CheckoutSessionPaymentStatusPaid {
+		p.logger.Info("Credit pack checkout completed but payment is still pending", zap.String("session_id", session.ID))
+		return nil
 	}
 
-	if value <= 0 {
-		return fmt.Errorf("Invalid amount of credits included (%d) in the subscription %s", value, subscriptionId)
+	customerId := session.Customer.ID
+	p.logCustomer(customerId, "Credit Pack Purchased")
+
+	team, err := p.database.GetTeamByStripeCustomerId(ctx, sql.NullString{Valid: true, String: customerId})
+	if err != nil {
+		return fmt.Errorf("Could not fetch team from DB using stripe customer ID %s: %s", customerId, err.Error())
 	}
 
-	interval, _ := p.GetSubscriptionInterval(subscriptionId)
+	credits, ok := session.Metadata["credits"]
+	if !ok {
+		return fmt.Errorf("No credits field in the checkout session %s metadata", session.ID)
+	}
 
-	if interval == stripe.PlanIntervalYear {
-		value *= 12
+	value, err := strconv.Atoi(credits)
+	if err != nil || value <= 0 {
+		return fmt.Errorf("Invalid amount of credits '%s' in the checkout session %s", credits, session.ID)
 	}
 
 	sub_plan, err := p.database.AddSubscriptionCreditsByTeamId(ctx, database.AddSubscriptionCreditsByTeamIdParams{
@@ -245,21 +531,13 @@ func (p *Payments) handleInvoicePaid(ctx context.Context, invoice stripe.Invoice
 		return fmt.Errorf("Unable to add %d credits to team %d: %s", value, team.ID, err.Error())
 	}
 
-	sub_plan, err = p.database.SetSubscriptionStripeIdByTeamId(ctx, database.SetSubscriptionStripeIdByTeamIdParams{
-		TeamID:               team.ID,
-		StripeSubscriptionID: sql.NullString{Valid: true, String: invoice.Subscription.ID},
-	})
-
-	if err != nil {
-		return fmt.Errorf("Unable to update stripe subscription id for team: %d: %s", team.ID, err.Error())
-	}
-
 	p.logger.Info(
-		"Successfully granted credits to team",
+		"Successfully granted credit pack to team",
 		zap.Int("credits_added", value),
 		zap.Int64("new_credit_amount", sub_plan.RemainingCredits),
 		zap.String("team_name", team.Name),
 		zap.Int64("team_id", team.ID),
+		zap.String("session_id", session.ID),
 	)
 
 	return nil
@@ -268,7 +546,18 @@ func (p *Payments) handleInvoicePaid(ctx context.Context, invoice stripe.Invoice
 func (p *Payments) handleInvoicePaymentFailed(ctx context.Context, invoice stripe.Invoice) error {
 	customerId := invoice.Customer.ID
 	p.logCustomer(customerId, "Subscription Invoice Payment Failed")
-	return nil
+
+	// A failed first payment leaves the subscription incomplete, there is no plan to put past due yet
+	if invoice.Subscription == nil || invoice.BillingReason == stripe.InvoiceBillingReasonSubscriptionCreate {
+		return nil
+	}
+
+	team, err := p.getTeamByCustomerId(ctx, customerId)
+	if err != nil {
+		return err
+	}
+
+	return p.transitionSubscriptionStatus(ctx, team, stripe.SubscriptionStatusPastDue)
 }
 
 func (p *Payments) logCustomer(stripeCustomerId string, event string) {
@@ -7,40 +7,77 @@ import (
 	"fmt"
 	"os"
 	"planetcastdev/httpmiddleware"
+	"planetcastdev/scheduler"
+	"strconv"
+	"sync"
 	"time"
 
 	"go.uber.org/zap"
-	"golang.org/x/sync/semaphore"
+)
+
+const (
+	// How often predictions are polled when webhooks are not set up
+	pollInterval = time.Second
+	// With webhooks, polling only catches callbacks that never arrived
+	webhookPollInterval = 30 * time.Second
+	// Predictions that run longer than this are cancelled
+	defaultPredictionTimeout = 60 * time.Minute
 )
 
 type ReplicateConnectProps struct {
-	Logger *zap.Logger
+	Logger    *zap.Logger
+	Scheduler *scheduler.Scheduler
+	Http      *httpmiddleware.Client
 }
 
 type Replicate struct {
-	logger               *zap.Logger
-	postRequestSemaphore *semaphore.Weighted
-	getRequestSemaphore  *semaphore.Weighted
+	logger    *zap.Logger
+	scheduler *scheduler.Scheduler
+	http      *httpmiddleware.Client
+
+	webhookUrl        string
+	webhookSecret     string
+	predictionTimeout time.Duration
+
+	mutex   sync.Mutex
+	waiting map[string]chan ReplicateGetRequestOutput
+	// Callbacks that arrived before anyone was waiting for them
+	early map[string]earlyCallback
 }
 
 func Connect(args ReplicateConnectProps) *Replicate {
-	maxPostRequestWorkers := 10
-	postReqSem := semaphore.NewWeighted(int64(maxPostRequestWorkers))
+	predictionTimeout := defaultPredictionTimeout
+	minutes, err := strconv.Atoi(os.Getenv("REPLICATE_PREDICTION_TIMEOUT_MINUTES"))
+	if err == nil && minutes > 0 {
+		predictionTimeout = time.Duration(minutes) * time.Minute
+	}
 
-	maxGetRequestWorkers := 50
-	getReqSem := semaphore.NewWeighted(int64(maxGetRequestWorkers))
+	r := &Replicate{
+		logger:            args.Logger,
+		scheduler:         args.Scheduler,
+		http:              args.Http,
+		webhookUrl:        os.Getenv("REPLICATE_WEBHOOK_URL"),
+		webhookSecret:     os.Getenv("REPLICATE_WEBHOOK_SECRET"),
+		predictionTimeout: predictionTimeout,
+		waiting:           map[string]chan ReplicateGetRequestOutput{},
+		early:             map[string]earlyCallback{},
+	}
 
-	return &Replicate{
-		logger:               args.Logger,
-		postRequestSemaphore: postReqSem,
-		getRequestSemaphore:  getReqSem,
+	if r.webhookUrl != "" && r.webhookSecret == "" {
+		args.Logger.Warn("REPLICATE_WEBHOOK_SECRET is not set, Replicate webhooks are disabled")
+		r.webhookUrl = ""
 	}
+	args.Logger.Info("Setting Up Replicate Middleware", zap.Bool("webhooks", r.webhookUrl != ""), zap.Duration("prediction_timeout", predictionTimeout))
+
+	return r
 }
 
 type ReplicateGetRequestOutput struct {
 	ID     string `json:"id"`
 	Output *any   `json:"output"`
 	Status string `json:"status"`
+	Error  any    `json:"error"`
+	Logs   string `json:"logs"`
 }
 
 type ReplicateTriggerRequestOutput struct {
@@ -49,6 +86,26 @@ type ReplicateTriggerRequestOutput struct {
 	Status string  `json:"status"`
 }
 
+// The end of a prediction's logs is kept with its error, which is usually
+// where the model explains what went wrong
+const maxErrorLogLength = 2000
+
+func (p *ReplicateGetRequestOutput) isDone() bool {
+	return p.Status == "succeeded" || p.Status == "failed" || p.Status == "canceled"
+}
+
+func (p *ReplicateGetRequestOutput) getError() error {
+	logs := p.Logs
+	if len(logs) > maxErrorLogLength {
+		logs = logs[len(logs)-maxErrorLogLength:]
+	}
+	return fmt.Errorf("Replicate prediction %s %s: %v\n%s", p.ID, p.Status, p.Error, logs)
+}
+
+// MakeRequest starts a prediction and waits for it to finish, returning its
+// output. The result comes from the webhook when one is set up, polling
+// picks up anything the webhook missed. Predictions that run past the
+// timeout, or whose caller gives up, are cancelled.
 func (r *Replicate) MakeRequest(ctx context.Context, body *bytes.Buffer, url string) (any, error) {
 
 	requestId, err := r.TriggerRequest(ctx, body, url)
@@ -57,18 +114,49 @@ func (r *Replicate) MakeRequest(ctx context.Context, body *bytes.Buffer, url str
 		return "", err
 	}
 
+	done := r.wait(requestId)
+	defer r.stopWaiting(requestId)
+
+	interval := pollInterval
+	if r.webhookUrl != "" {
+		interval = webhookPollInterval
+	}
+
+	deadline := time.NewTimer(r.predictionTimeout)
+	defer deadline.Stop()
+
+	poll := time.NewTicker(interval)
+	defer poll.Stop()
+
 	for {
-		requestOutput, err := r.FetchRequest(ctx, requestId)
-		if err != nil {
-			return "", err
+		var prediction ReplicateGetRequestOutput
+
+		select {
+		case prediction = <-done:
+		case <-poll.C:
+			requestOutput, err := r.FetchRequest(ctx, requestId)
+			if err != nil {
+				r.logger.Warn("Could not poll replicate prediction", zap.Error(err), zap.String("prediction_id", requestId))
+				continue
+			}
+			prediction = *requestOutput
+		case <-deadline.C:
+			r.CancelRequest(requestId)
+			return "", fmt.Errorf("Replicate prediction %s did not finish within %s", requestId, r.predictionTimeout)
+		case <-ctx.Done():
+			r.CancelRequest(requestId)
+			return "", ctx.Err()
 		}
-		if requestOutput.Status == "succeeded" {
-			return *requestOutput.Output, nil
+
+		if !prediction.isDone() {
+			continue
 		}
-		if requestOutput.Status == "failed" {
-			return "", fmt.Errorf("Replicate failed to sync video")
+		if prediction.Status != "succeeded" || prediction.Output == nil {
+			err := prediction.getError()
+			r.logger.Error("Replicate prediction did not succeed", zap.Error(err), zap.String("prediction_id", requestId), zap.String("status", prediction.Status))
+			return "", err
 		}
-		time.Sleep(500 * time.Millisecond)
+		return *prediction.Output, nil
 	}
 
 }
@@ -77,12 +165,13 @@ func (r *Replicate) FetchRequest(ctx context.Context, requestId string) (*Replic
 
 	API_KEY := os.Getenv("REPLICATE_KEY")
 
-	if err := r.getRequestSemaphore.Acquire(ctx, 1); err != nil {
-		return nil, fmt.Errorf("Failed to acquire semaphore.")
+	release, err := r.scheduler.Acquire(ctx, scheduler.ReplicateGet)
+	if err != nil {
+		return nil, err
 	}
-	defer r.getRequestSemaphore.Release(1)
+	defer release()
 
-	responseBody, err := httpmiddleware.HttpRequest(httpmiddleware.HttpRequestStruct{
+	responseBody, err := r.http.HttpRequest(ctx, httpmiddleware.HttpRequestStruct{
 		Method: "GET",
 		Url:    fmt.Sprintf("https://api.replicate.com/v1/predictions/%s", requestId),
 		Headers: map[string]string{
@@ -95,7 +184,10 @@ func (r *Replicate) FetchRequest(ctx context.Context, requestId string) (*Replic
 	}
 
 	var replicateOutput ReplicateGetRequestOutput
-	json.Unmarshal(responseBody, &replicateOutput)
+	err = json.Unmarshal(responseBody, &replicateOutput)
+	if err != nil || replicateOutput.ID == "" {
+		return nil, fmt.Errorf("Could not parse replicate prediction %s: %s", requestId, responseBody)
+	}
 
 	return &replicateOutput, nil
 
@@ -105,18 +197,29 @@ func (r *Replicate) TriggerRequest(ctx context.Context, body *bytes.Buffer, url
 
 	API_KEY := os.Getenv("REPLICATE_KEY")
 
-	if err := r.postRequestSemaphore.Acquire(ctx, 1); err != nil {
-		return "", fmt.Errorf("Failed to acquire semaphore.")
+	requestBody := body.Bytes()
+	if r.webhookUrl != "" {
+		var err error
+		requestBody, err = withWebhook(requestBody, r.webhookUrl)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	release, err := r.scheduler.Acquire(ctx, scheduler.ReplicatePost)
+	if err != nil {
+		return "", err
 	}
-	defer r.postRequestSemaphore.Release(1)
+	defer release()
 
-	responseBody, err := httpmiddleware.HttpRequest(httpmiddleware.HttpRequestStruct{
+	responseBody, err := r.http.HttpRequest(ctx, httpmiddleware.HttpRequestStruct{
 		Method: "POST",
 		Url:    url,
 		Headers: map[string]string{
 			"Authorization": fmt.Sprintf("Token %s", API_KEY),
+			"Content-Type":  "application/json",
 		},
-		Body: body,
+		Body: requestBody,
 	})
 
 	if err != nil {
@@ -124,11 +227,42 @@ func (r *Replicate) TriggerRequest(ctx context.Context, body *bytes.Buffer, url
 	}
 
 	var replicateOutput ReplicateTriggerRequestOutput
-	json.Unmarshal(responseBody, &replicateOutput)
+	err = json.Unmarshal(responseBody, &replicateOutput)
+	if err != nil {
+		return "", fmt.Errorf("Could not parse replicate response: %s", err.Error())
+	}
 
 	if replicateOutput.Error != nil {
 		return "", fmt.Errorf("Replicate request failed: %s", *replicateOutput.Error)
 	}
+	if replicateOutput.ID == "" {
+		return "", fmt.Errorf("Replicate response has no prediction id: %s", responseBody)
+	}
 
 	return replicateOutput.ID, nil
 }
+
+// CancelRequest stops a prediction so it is no longer billed. It runs on its
+// own context as it is usually called once the caller's has ended.
+func (r *Replicate) CancelRequest(requestId string) {
+
+	API_KEY := os.Getenv("REPLICATE_KEY")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	_, err := r.http.HttpRequest(ctx, httpmiddleware.HttpRequestStruct{
+		Method: "POST",
+		Url:    fmt.Sprintf("https://api.replicate.com/v1/predictions/%s/cancel", requestId),
+		Headers: map[string]string{
+			"Authorization": fmt.Sprintf("Token %s", API_KEY),
+		},
+		Idempotent: true,
+	})
+
+	if err != nil {
+		r.logger.Error("Could not cancel replicate prediction", zap.Error(err), zap.String("prediction_id", requestId))
+		return
+	}
+	r.logger.Info("Cancelled replicate prediction", zap.String("prediction_id", requestId))
+}
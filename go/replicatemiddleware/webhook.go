@@ -0,0 +1,173 @@
+package replicatemiddleware
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// Callbacks signed further from now than this are rejected as replays
+	webhookTolerance = 5 * time.Minute
+	// Early callbacks nobody claims are dropped after this, they may belong
+	// to another server
+	earlyCallbackTTL = 10 * time.Minute
+	// Early callbacks past this many are dropped, polling still picks up
+	// their predictions
+	maxEarlyCallbacks = 1000
+)
+
+type earlyCallback struct {
+	prediction ReplicateGetRequestOutput
+	received   time.Time
+}
+
+// withWebhook asks Replicate to call us back once the prediction is done.
+func withWebhook(body []byte, webhookUrl string) ([]byte, error) {
+	var request map[string]any
+	err := json.Unmarshal(body, &request)
+	if err != nil {
+		return nil, fmt.Errorf("Could not read replicate request body: %s", err.Error())
+	}
+
+	request["webhook"] = webhookUrl
+	request["webhook_events_filter"] = []string{"completed"}
+
+	return json.Marshal(request)
+}
+
+// wait registers interest in a prediction's callback, handing over one that
+// already arrived.
+func (r *Replicate) wait(predictionId string) chan ReplicateGetRequestOutput {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	done := make(chan ReplicateGetRequestOutput, 1)
+	if callback, ok := r.early[predictionId]; ok {
+		delete(r.early, predictionId)
+		done <- callback.prediction
+	}
+	r.waiting[predictionId] = done
+
+	return done
+}
+
+func (r *Replicate) stopWaiting(predictionId string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	delete(r.waiting, predictionId)
+}
+
+func (r *Replicate) resolve(prediction ReplicateGetRequestOutput) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	done, ok := r.waiting[prediction.ID]
+	if !ok {
+		now := time.Now()
+		for id, callback := range r.early {
+			if now.Sub(callback.received) > earlyCallbackTTL {
+				delete(r.early, id)
+			}
+		}
+		if len(r.early) >= maxEarlyCallbacks {
+			r.logger.Warn("Too many unclaimed replicate webhooks, dropping callback", zap.String("prediction_id", prediction.ID))
+			return
+		}
+		r.early[prediction.ID] = earlyCallback{prediction: prediction, received: now}
+		return
+	}
+
+	select {
+	case done <- prediction:
+	default:
+	}
+}
+
+// verifyWebhook checks the signature Replicate puts on every callback. The
+// id, timestamp and body are signed with the webhook secret, and the
+// timestamp has to be recent.
+func verifyWebhook(secret string, header http.Header, body []byte) error {
+	id := header.Get("webhook-id")
+	timestamp := header.Get("webhook-timestamp")
+	signatures := header.Get("webhook-signature")
+	if id == "" || timestamp == "" || signatures == "" {
+		return fmt.Errorf("Missing webhook signature headers")
+	}
+
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("Invalid webhook timestamp: %s", timestamp)
+	}
+	if math.Abs(time.Since(time.Unix(seconds, 0)).Seconds()) > webhookTolerance.Seconds() {
+		return fmt.Errorf("Webhook timestamp is too far from now: %s", timestamp)
+	}
+
+	key, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(secret, "whsec_"))
+	if err != nil {
+		return fmt.Errorf("Invalid webhook secret: %s", err.Error())
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(id + "." + timestamp + "."))
+	mac.Write(body)
+	expected := mac.Sum(nil)
+
+	// Several signatures may be sent while the secret is being rotated
+	for _, signature := range strings.Fields(signatures) {
+		version, value, found := strings.Cut(signature, ",")
+		if !found || version != "v1" {
+			continue
+		}
+		decoded, err := base64.StdEncoding.DecodeString(value)
+		if err == nil && hmac.Equal(decoded, expected) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("Webhook signature does not match")
+}
+
+// HandleWebhook receives the callback for a finished prediction and hands it
+// to the job waiting on it. Without a webhook secret callbacks cannot be
+// verified, so the endpoint does not exist.
+func (r *Replicate) HandleWebhook(w http.ResponseWriter, req *http.Request) {
+	if r.webhookSecret == "" {
+		http.NotFound(w, req)
+		return
+	}
+
+	const MaxBodyBytes = int64(1 << 20)
+	payload, err := io.ReadAll(http.MaxBytesReader(w, req.Body, MaxBodyBytes))
+	if err != nil {
+		http.Error(w, "Error reading request body", http.StatusBadRequest)
+		return
+	}
+
+	err = verifyWebhook(r.webhookSecret, req.Header, payload)
+	if err != nil {
+		r.logger.Warn("Rejected replicate webhook", zap.Error(err))
+		http.Error(w, "Error verifying webhook signature", http.StatusUnauthorized)
+		return
+	}
+
+	var prediction ReplicateGetRequestOutput
+	err = json.Unmarshal(payload, &prediction)
+	if err != nil || prediction.ID == "" {
+		http.Error(w, "Error parsing webhook JSON", http.StatusBadRequest)
+		return
+	}
+
+	r.logger.Info("Received replicate webhook", zap.String("prediction_id", prediction.ID), zap.String("status", prediction.Status))
+	r.resolve(prediction)
+}
@@ -0,0 +1,26 @@
+package scheduler
+
+import "context"
+
+// Owner is the team work is being done for, which decides its place in line.
+type Owner struct {
+	TeamID   int64
+	Priority int
+}
+
+type ownerContextKey struct{}
+
+func WithOwner(ctx context.Context, owner Owner) context.Context {
+	return context.WithValue(ctx, ownerContextKey{}, owner)
+}
+
+// OwnerFromContext returns the owner attached to the context. Work without
+// one, like background maintenance, is treated as its own team with no
+// priority.
+func OwnerFromContext(ctx context.Context) Owner {
+	owner, ok := ctx.Value(ownerContextKey{}).(Owner)
+	if !ok {
+		return Owner{}
+	}
+	return owner
+}
@@ -0,0 +1,316 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+// Resource is something with a limited number of slots shared by every job
+// running on this server.
+type Resource string
+
+const (
+	// Dubbing jobs running at once, the rest wait in line
+	Jobs          Resource = "JOBS"
+	Ffmpeg        Resource = "FFMPEG"
+	OpenAI        Resource = "OPENAI"
+	ElevenLabs    Resource = "ELEVENLABS"
+	ReplicatePost Resource = "REPLICATE_POST"
+	ReplicateGet  Resource = "REPLICATE_GET"
+)
+
+// Slots for each resource, each can be changed with SCHEDULER_<RESOURCE>_LIMIT
+var defaultLimits = map[Resource]int{
+	Jobs:          4,
+	Ffmpeg:        4,
+	OpenAI:        3,
+	ElevenLabs:    5,
+	ReplicatePost: 10,
+	ReplicateGet:  50,
+}
+
+const defaultSegmentWorkers = 4
+
+// Plans are matched on their tier, anything not listed has no priority
+const defaultPlanPriorities = "ENTERPRISE:3,BUSINESS:2,PRO:1"
+
+type waiter struct {
+	owner            Owner
+	transformationID int64
+	seq              uint64
+	ready            chan struct{}
+}
+
+type resource struct {
+	limit   int
+	inUse   int
+	held    map[int64]int
+	waiters []*waiter
+	// When each team was last given a slot, so teams take turns
+	lastServed map[int64]uint64
+	served     uint64
+}
+
+// Scheduler hands out slots on the shared resources. When a resource is full
+// callers wait in line, ordered by plan priority and then by how many slots
+// their team already holds, so a single large job cannot starve everyone
+// else. The line is kept in memory, it only covers this server.
+type Scheduler struct {
+	logger         *zap.Logger
+	mutex          sync.Mutex
+	resources      map[Resource]*resource
+	seq            uint64
+	segmentWorkers int
+	planPriorities map[string]int
+}
+
+type SchedulerConnectProps struct {
+	Logger *zap.Logger
+}
+
+func getEnvInt(name string, fallback int) int {
+	value, err := strconv.Atoi(os.Getenv(name))
+	if err != nil || value <= 0 {
+		return fallback
+	}
+	return value
+}
+
+func parsePlanPriorities(value string) map[string]int {
+	priorities := map[string]int{}
+	for _, entry := range strings.Split(value, ",") {
+		tier, priority, found := strings.Cut(strings.TrimSpace(entry), ":")
+		if !found {
+			continue
+		}
+		p, err := strconv.Atoi(priority)
+		if err != nil {
+			continue
+		}
+		priorities[strings.ToUpper(strings.TrimSpace(tier))] = p
+	}
+	return priorities
+}
+
+func Connect(args SchedulerConnectProps) *Scheduler {
+	resources := map[Resource]*resource{}
+	limits := []zap.Field{}
+	for name, fallback := range defaultLimits {
+		limit := getEnvInt(fmt.Sprintf("SCHEDULER_%s_LIMIT", name), fallback)
+		resources[name] = &resource{limit: limit, held: map[int64]int{}, lastServed: map[int64]uint64{}}
+		limits = append(limits, zap.Int(strings.ToLower(string(name)), limit))
+	}
+
+	planPriorities := os.Getenv("SCHEDULER_PLAN_PRIORITIES")
+	if planPriorities == "" {
+		planPriorities = defaultPlanPriorities
+	}
+
+	s := &Scheduler{
+		logger:         args.Logger,
+		resources:      resources,
+		segmentWorkers: getEnvInt("SCHEDULER_SEGMENT_WORKERS", defaultSegmentWorkers),
+		planPriorities: parsePlanPriorities(planPriorities),
+	}
+
+	args.Logger.Info("Setting Up Scheduler", append(limits, zap.Int("segment_workers", s.segmentWorkers), zap.Any("plan_priorities", s.planPriorities))...)
+	return s
+}
+
+// SegmentWorkers is how many segments of a single job are processed at once.
+func (s *Scheduler) SegmentWorkers() int {
+	return s.segmentWorkers
+}
+
+// PlanPriority returns the priority of a team's jobs, from its plan tier.
+// Only plans that are paid up get priority.
+func (s *Scheduler) PlanPriority(planTier string, subscriptionStatus string) int {
+	if subscriptionStatus != "active" && subscriptionStatus != "trialing" {
+		return 0
+	}
+	return s.planPriorities[strings.ToUpper(planTier)]
+}
+
+// Acquire waits for a slot on the resource and returns the function that
+// gives it back. The caller is identified by the Owner in the context.
+func (s *Scheduler) Acquire(ctx context.Context, name Resource) (func(), error) {
+	return s.acquire(ctx, name, 0)
+}
+
+// AcquireJob waits for one of the slots for running dubbing jobs. The
+// transformation is tracked so its place in line can be looked up.
+func (s *Scheduler) AcquireJob(ctx context.Context, transformationID int64) (func(), error) {
+	return s.acquire(ctx, Jobs, transformationID)
+}
+
+func (s *Scheduler) acquire(ctx context.Context, name Resource, transformationID int64) (func(), error) {
+	owner := OwnerFromContext(ctx)
+
+	s.mutex.Lock()
+	r := s.resources[name]
+
+	if r.inUse < r.limit && len(r.waiters) == 0 {
+		r.take(owner.TeamID)
+		s.mutex.Unlock()
+		return s.releaseFunc(name, owner.TeamID), nil
+	}
+
+	s.seq++
+	w := &waiter{owner: owner, transformationID: transformationID, seq: s.seq, ready: make(chan struct{})}
+	r.waiters = append(r.waiters, w)
+	s.mutex.Unlock()
+
+	if name == Jobs {
+		s.logger.Info(
+			"Job queued",
+			zap.Int64("team_id", owner.TeamID),
+			zap.Int64("transformation_id", transformationID),
+			zap.Int("priority", owner.Priority),
+			zap.Int("queue_position", s.QueuePosition(transformationID)),
+		)
+	}
+
+	select {
+	case <-w.ready:
+		return s.releaseFunc(name, owner.TeamID), nil
+	case <-ctx.Done():
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+
+		select {
+		case <-w.ready:
+			// The slot was handed over just as the context ended
+			r.give(owner.TeamID)
+			s.dispatch(r)
+		default:
+			r.remove(w)
+		}
+		return nil, fmt.Errorf("Gave up waiting for %s: %s", strings.ToLower(string(name)), ctx.Err().Error())
+	}
+}
+
+func (s *Scheduler) releaseFunc(name Resource, teamID int64) func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			s.mutex.Lock()
+			defer s.mutex.Unlock()
+
+			r := s.resources[name]
+			r.give(teamID)
+			s.dispatch(r)
+		})
+	}
+}
+
+// dispatch hands free slots to the front of the line.
+func (s *Scheduler) dispatch(r *resource) {
+	for r.inUse < r.limit && len(r.waiters) > 0 {
+		w := r.waiters[next(r.waiters, r.held, r.lastServed)]
+		r.remove(w)
+		r.take(w.owner.TeamID)
+		close(w.ready)
+	}
+}
+
+// QueuePosition estimates where a transformation is in line for a job slot,
+// starting at 1, or 0 when it is not waiting. Later arrivals with a higher
+// priority, and the order running jobs finish in, can still move it.
+func (s *Scheduler) QueuePosition(transformationID int64) int {
+	if transformationID == 0 {
+		return 0
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	r := s.resources[Jobs]
+
+	// Play the line forward from the slots teams hold now, as if every
+	// waiter got a slot in turn and no running job finished first
+	waiters := append([]*waiter{}, r.waiters...)
+	held := map[int64]int{}
+	for team, count := range r.held {
+		held[team] = count
+	}
+	lastServed := map[int64]uint64{}
+	for team, served := range r.lastServed {
+		lastServed[team] = served
+	}
+	served := r.served
+
+	for position := 1; len(waiters) > 0; position++ {
+		idx := next(waiters, held, lastServed)
+		if waiters[idx].transformationID == transformationID {
+			return position
+		}
+		served++
+		held[waiters[idx].owner.TeamID]++
+		lastServed[waiters[idx].owner.TeamID] = served
+		waiters = append(waiters[:idx], waiters[idx+1:]...)
+	}
+
+	return 0
+}
+
+// next picks the waiter that gets the next slot: highest priority first,
+// then the team holding the fewest slots, then the team that was served
+// longest ago, then whoever has waited longest.
+func next(waiters []*waiter, held map[int64]int, lastServed map[int64]uint64) int {
+	best := 0
+	for i, w := range waiters[1:] {
+		b := waiters[best]
+		if w.owner.Priority != b.owner.Priority {
+			if w.owner.Priority > b.owner.Priority {
+				best = i + 1
+			}
+			continue
+		}
+		if held[w.owner.TeamID] != held[b.owner.TeamID] {
+			if held[w.owner.TeamID] < held[b.owner.TeamID] {
+				best = i + 1
+			}
+			continue
+		}
+		if lastServed[w.owner.TeamID] != lastServed[b.owner.TeamID] {
+			if lastServed[w.owner.TeamID] < lastServed[b.owner.TeamID] {
+				best = i + 1
+			}
+			continue
+		}
+		if w.seq < b.seq {
+			best = i + 1
+		}
+	}
+	return best
+}
+
+func (r *resource) take(teamID int64) {
+	r.inUse++
+	r.held[teamID]++
+	r.served++
+	r.lastServed[teamID] = r.served
+}
+
+func (r *resource) give(teamID int64) {
+	r.inUse--
+	r.held[teamID]--
+	if r.held[teamID] <= 0 {
+		delete(r.held, teamID)
+	}
+}
+
+func (r *resource) remove(w *waiter) {
+	for i, other := range r.waiters {
+		if other == w {
+			r.waiters = append(r.waiters[:i], r.waiters[i+1:]...)
+			return
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net/http"
 	"os"
@@ -11,10 +12,13 @@ import (
 	"planetcastdev/email"
 	"planetcastdev/ffmpegmiddleware"
 	"planetcastdev/graph"
+	"planetcastdev/httpmiddleware"
 	"planetcastdev/logmiddleware"
+	"planetcastdev/mediaassets"
 	"planetcastdev/openaimiddleware"
 	"planetcastdev/paymentsmiddleware"
 	"planetcastdev/replicatemiddleware"
+	"planetcastdev/scheduler"
 	"planetcastdev/storage"
 	"planetcastdev/youtubemiddleware"
 
@@ -43,42 +47,53 @@ func main() {
 		Logger.Info(".env: Loaded environment variables")
 	}
 
-	Replicate := replicatemiddleware.Connect(replicatemiddleware.ReplicateConnectProps{Logger: Logger})
-	ElevenLabs := elevenlabsmiddleware.Connect(elevenlabsmiddleware.ElevenLabsConnectProps{Logger: Logger})
-	OpenAI := openaimiddleware.Connect(openaimiddleware.OpenAIConnectProps{Logger: Logger})
+	Scheduler := scheduler.Connect(scheduler.SchedulerConnectProps{Logger: Logger})
+	Http := httpmiddleware.Connect(httpmiddleware.HttpConnectProps{Logger: Logger})
+	Replicate := replicatemiddleware.Connect(replicatemiddleware.ReplicateConnectProps{Logger: Logger, Scheduler: Scheduler, Http: Http})
+	ElevenLabs := elevenlabsmiddleware.Connect(elevenlabsmiddleware.ElevenLabsConnectProps{Logger: Logger, Scheduler: Scheduler, Http: Http})
+	OpenAI := openaimiddleware.Connect(openaimiddleware.OpenAIConnectProps{Logger: Logger, Scheduler: Scheduler, Http: Http})
 	Email := email.Connect(email.EmailConnectProps{Logger: Logger})
-	Ffmpeg := ffmpegmiddleware.Connect(ffmpegmiddleware.FfmpegConnectProps{Logger: Logger})
+	Ffmpeg := ffmpegmiddleware.Connect(ffmpegmiddleware.FfmpegConnectProps{Logger: Logger, Scheduler: Scheduler})
 	Youtube := youtubemiddleware.Connect(youtubemiddleware.YoutubeConnectProps{Logger: Logger, Ffmpeg: Ffmpeg})
 	Storage := storage.Connect(storage.StorageConnectProps{Logger: Logger})
 	Database := database.Connect(database.DatabaseConnectProps{Logger: Logger})
+	MediaAssets := mediaassets.Connect(mediaassets.MediaAssetsConnectProps{Storage: Storage, Database: Database, Logger: Logger})
 
 	Payments := paymentsmiddleware.Connect(
 		paymentsmiddleware.PaymentsConnectProps{
 			Logger:   Logger,
 			Database: Database,
+			Email:    Email,
 		})
+	go Payments.RunReservationSettler(context.Background())
+	go MediaAssets.RunSweeper(context.Background())
 
 	Dubbing := dubbing.Connect(
 		dubbing.DubbingConnectProps{
-			Storage:    Storage,
-			Database:   Database,
-			Logger:     Logger,
-			Ffmpeg:     Ffmpeg,
-			Email:      Email,
-			Openai:     OpenAI,
-			Replicate:  Replicate,
-			ElevenLabs: ElevenLabs,
+			Storage:     Storage,
+			Database:    Database,
+			Logger:      Logger,
+			Ffmpeg:      Ffmpeg,
+			Email:       Email,
+			Openai:      OpenAI,
+			Replicate:   Replicate,
+			ElevenLabs:  ElevenLabs,
+			MediaAssets: MediaAssets,
+			Scheduler:   Scheduler,
+			Http:        Http,
 		})
 
 	GqlServer := graph.Connect(graph.GraphConnectProps{
-		Dubbing:  Dubbing,
-		Storage:  Storage,
-		Queries:  Database,
-		Logger:   Logger,
-		Email:    Email,
-		Youtube:  Youtube,
-		Ffmpeg:   Ffmpeg,
-		Payments: Payments,
+		Dubbing:     Dubbing,
+		Storage:     Storage,
+		Queries:     Database,
+		Logger:      Logger,
+		Email:       Email,
+		Youtube:     Youtube,
+		Ffmpeg:      Ffmpeg,
+		Payments:    Payments,
+		MediaAssets: MediaAssets,
+		Scheduler:   Scheduler,
 	})
 
 	router := chi.NewRouter()
@@ -87,11 +102,18 @@ func main() {
 		AllowedOrigins:   []string{"http://localhost:3000", "http://localhost:8080", "https://www.planetcast.ai", "https://planetcast.ai", "https://api.planetcast.ai"},
 		AllowCredentials: true,
 		AllowedHeaders:   []string{"Content-Type", "Authorization"},
+		AllowedMethods:   []string{"GET", "POST", "PUT", "HEAD"},
+		ExposedHeaders:   []string{"ETag"},
 		Debug:            false,
 	}).Handler)
 
 	router.Handle("/", GqlServer)
 	router.Post("/stripe-webhook", Payments.HandleStripeWebhook)
+	router.Post("/replicate-webhook", Replicate.HandleWebhook)
+
+	if storageHandler := Storage.SignedURLHandler(); storageHandler != nil {
+		router.Handle("/storage/*", storageHandler)
+	}
 
 	if production == false {
 		Logger.Info("Connect to http://localhost:" + port + " for GraphQL server")
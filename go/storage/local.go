@@ -0,0 +1,384 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type localBackendProps struct {
+	Root          string
+	PublicURL     string
+	SigningSecret string
+	Logger        *zap.Logger
+}
+
+// localBackend keeps files on disk for development. Signed URLs point at the
+// API server, which checks the signature and streams the file.
+type localBackend struct {
+	root      string
+	publicUrl string
+	secret    []byte
+	logger    *zap.Logger
+}
+
+func newLocalBackend(args localBackendProps) (*localBackend, error) {
+	root := args.Root
+	if root == "" {
+		root = "storage_data"
+	}
+
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.MkdirAll(root, 0755)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to create local storage directory %s: %s", root, err.Error())
+	}
+
+	publicUrl := args.PublicURL
+	if publicUrl == "" {
+		publicUrl = "http://localhost:8080"
+	}
+
+	secret := []byte(args.SigningSecret)
+	if len(secret) == 0 {
+		// Links signed with a random secret stop working when the server restarts
+		secret = make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return nil, err
+		}
+		args.Logger.Warn("STORAGE_SIGNING_SECRET is not set, using a random secret for signed URLs")
+	}
+
+	return &localBackend{
+		root:      root,
+		publicUrl: strings.TrimSuffix(publicUrl, "/"),
+		secret:    secret,
+		logger:    args.Logger,
+	}, nil
+}
+
+// filePath maps a key to a path inside the storage directory. Cleaning the
+// key as an absolute path stops keys from escaping the directory.
+func (b *localBackend) filePath(key string) string {
+	return filepath.Join(b.root, filepath.FromSlash(path.Clean("/"+key)))
+}
+
+func (b *localBackend) Put(ctx context.Context, key string, body io.Reader) error {
+	filePath := b.filePath(key)
+
+	err := os.MkdirAll(filepath.Dir(filePath), 0755)
+	if err != nil {
+		return fmt.Errorf("Unable to create directory for %s: %s", key, err.Error())
+	}
+
+	// Write to a temporary file first so readers never see a partial file
+	tmpFile, err := os.CreateTemp(filepath.Dir(filePath), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("Unable to create file for %s: %s", key, err.Error())
+	}
+	defer os.Remove(tmpFile.Name())
+
+	_, err = io.Copy(tmpFile, body)
+	if closeErr := tmpFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("Unable to write %s: %s", key, err.Error())
+	}
+
+	return os.Rename(tmpFile.Name(), filePath)
+}
+
+func (b *localBackend) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	file, err := os.Open(b.filePath(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	return file, err
+}
+
+func (b *localBackend) Delete(ctx context.Context, key string) error {
+	err := os.Remove(b.filePath(key))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("Unable to delete %s: %s", key, err.Error())
+	}
+	return nil
+}
+
+func (b *localBackend) Stat(ctx context.Context, key string) (ObjectInfo, error) {
+	info, err := os.Stat(b.filePath(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return ObjectInfo{}, ErrNotFound
+	}
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+	return ObjectInfo{Key: key, Size: info.Size(), LastModified: info.ModTime()}, nil
+}
+
+func (b *localBackend) List(ctx context.Context, prefix string) ([]ObjectInfo, error) {
+	var objects []ObjectInfo
+
+	err := filepath.WalkDir(b.root, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() && entry.Name() == multipartDir {
+			return filepath.SkipDir
+		}
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".upload-") {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(b.root, filePath)
+		if err != nil {
+			return err
+		}
+
+		key := filepath.ToSlash(relPath)
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+
+		objects = append(objects, ObjectInfo{Key: key, Size: info.Size(), LastModified: info.ModTime()})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Unable to list objects with prefix %s: %s", prefix, err.Error())
+	}
+
+	return objects, nil
+}
+
+// sign ties a signature to the HTTP method as well as the path, so a link to
+// download a file cannot be used to overwrite it.
+func (b *localBackend) sign(method string, key string, expires int64) string {
+	mac := hmac.New(sha256.New, b.secret)
+	mac.Write([]byte(fmt.Sprintf("%s\n%s\n%d", method, key, expires)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (b *localBackend) signedURL(method string, key string, expiry time.Duration) string {
+	expires := time.Now().Add(expiry).Unix()
+
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(expires, 10))
+	query.Set("signature", b.sign(method, key, expires))
+
+	escapedKey := (&url.URL{Path: key}).EscapedPath()
+
+	return fmt.Sprintf("%s/storage/%s?%s", b.publicUrl, escapedKey, query.Encode())
+}
+
+func (b *localBackend) SignedURL(ctx context.Context, key string, expiry time.Duration) (string, error) {
+	return b.signedURL(http.MethodGet, key, expiry), nil
+}
+
+// ServeHTTP serves files for URLs created by SignedURL and accepts parts for
+// URLs created by SignedPartURL. It expects to be mounted under /storage/.
+func (b *localBackend) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	key := strings.TrimPrefix(r.URL.Path, "/storage/")
+
+	method := r.Method
+	if method == http.MethodHead {
+		method = http.MethodGet
+	}
+
+	expires, err := strconv.ParseInt(r.URL.Query().Get("expires"), 10, 64)
+	if err != nil || time.Now().Unix() > expires {
+		http.Error(w, "Link expired", http.StatusForbidden)
+		return
+	}
+
+	signature, err := hex.DecodeString(r.URL.Query().Get("signature"))
+	expected, _ := hex.DecodeString(b.sign(method, key, expires))
+	if err != nil || !hmac.Equal(signature, expected) {
+		http.Error(w, "Invalid signature", http.StatusForbidden)
+		return
+	}
+
+	if method == http.MethodPut {
+		b.receivePart(w, r, key)
+		return
+	}
+
+	file, err := os.Open(b.filePath(key))
+	if err != nil {
+		http.Error(w, "Not found", http.StatusNotFound)
+		return
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		http.Error(w, "Not found", http.StatusNotFound)
+		return
+	}
+
+	http.ServeContent(w, r, path.Base(key), info.ModTime(), file)
+}
+
+// Parts of multipart uploads are kept in their own directory until the
+// upload is completed, List skips it.
+const multipartDir = ".multipart"
+
+func partKey(uploadId string, partNumber int64) string {
+	return fmt.Sprintf("%s/%s/%d", multipartDir, uploadId, partNumber)
+}
+
+func fileETag(filePath string) (string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := md5.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("\"%s\"", hex.EncodeToString(hash.Sum(nil))), nil
+}
+
+func (b *localBackend) receivePart(w http.ResponseWriter, r *http.Request, key string) {
+	if !strings.HasPrefix(key, multipartDir+"/") {
+		http.Error(w, "Invalid upload", http.StatusBadRequest)
+		return
+	}
+
+	uploadDir := filepath.Dir(b.filePath(key))
+	if _, err := os.Stat(uploadDir); err != nil {
+		http.Error(w, "Upload not found", http.StatusNotFound)
+		return
+	}
+
+	err := b.Put(r.Context(), key, r.Body)
+	if err != nil {
+		b.logger.Error("Could not store upload part", zap.Error(err), zap.String("key", key))
+		http.Error(w, "Could not store part", http.StatusInternalServerError)
+		return
+	}
+
+	etag, err := fileETag(b.filePath(key))
+	if err != nil {
+		http.Error(w, "Could not store part", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("ETag", etag)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (b *localBackend) CreateMultipartUpload(ctx context.Context, key string) (string, error) {
+	uploadId := uuid.NewString()
+
+	err := os.MkdirAll(filepath.Join(b.root, multipartDir, uploadId), 0755)
+	if err != nil {
+		return "", fmt.Errorf("Unable to start multipart upload for %s: %s", key, err.Error())
+	}
+
+	return uploadId, nil
+}
+
+func (b *localBackend) SignedPartURL(ctx context.Context, key string, uploadId string, partNumber int64, expiry time.Duration) (string, error) {
+	return b.signedURL(http.MethodPut, partKey(uploadId, partNumber), expiry), nil
+}
+
+func (b *localBackend) ListParts(ctx context.Context, key string, uploadId string) ([]CompletedPart, error) {
+	uploadDir := filepath.Join(b.root, multipartDir, path.Clean("/"+uploadId))
+
+	entries, err := os.ReadDir(uploadDir)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to list uploaded parts for %s: %s", key, err.Error())
+	}
+
+	parts := []CompletedPart{}
+	for _, entry := range entries {
+		partNumber, err := strconv.ParseInt(entry.Name(), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		etag, err := fileETag(filepath.Join(uploadDir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		parts = append(parts, CompletedPart{PartNumber: partNumber, ETag: etag})
+	}
+
+	sort.Slice(parts, func(i, j int) bool { return parts[i].PartNumber < parts[j].PartNumber })
+
+	return parts, nil
+}
+
+// CompleteMultipartUpload joins the parts in order into the final file. Like
+// S3, it fails if a part is missing or was changed since the client uploaded
+// it.
+func (b *localBackend) CompleteMultipartUpload(ctx context.Context, key string, uploadId string, parts []CompletedPart) error {
+	readers := []io.Reader{}
+
+	for _, part := range parts {
+		partPath := b.filePath(partKey(uploadId, part.PartNumber))
+
+		etag, err := fileETag(partPath)
+		if err != nil {
+			return fmt.Errorf("Part %d of upload %s not found", part.PartNumber, uploadId)
+		}
+		if etag != part.ETag {
+			return fmt.Errorf("Part %d of upload %s does not match its ETag", part.PartNumber, uploadId)
+		}
+
+		file, err := os.Open(partPath)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		readers = append(readers, file)
+	}
+
+	err := b.Put(ctx, key, io.MultiReader(readers...))
+	if err != nil {
+		return fmt.Errorf("Unable to complete multipart upload for %s: %s", key, err.Error())
+	}
+
+	return b.AbortMultipartUpload(ctx, key, uploadId)
+}
+
+func (b *localBackend) AbortMultipartUpload(ctx context.Context, key string, uploadId string) error {
+	err := os.RemoveAll(filepath.Join(b.root, multipartDir, path.Clean("/"+uploadId)))
+	if err != nil {
+		return fmt.Errorf("Unable to remove multipart upload %s: %s", uploadId, err.Error())
+	}
+	return nil
+}
@@ -1,92 +1,148 @@
 package storage
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/credentials"
-	"github.com/aws/aws-sdk-go/aws/session"
-	"github.com/aws/aws-sdk-go/service/s3"
-	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"go.uber.org/zap"
 )
 
-type Storage struct {
-	uploader *s3manager.Uploader
-	s3       *s3.S3
-	logger   *zap.Logger
+var ErrNotFound = errors.New("Object not found")
+
+type ObjectInfo struct {
+	Key          string
+	Size         int64
+	LastModified time.Time
 }
 
-func new(uploader *s3manager.Uploader, s3 *s3.S3, logger *zap.Logger) *Storage {
-	return &Storage{uploader: uploader, s3: s3, logger: logger}
+// Backend is implemented by every place media can be stored. Keys are the
+// file names saved in the database, backends map them to their own layout.
+type Backend interface {
+	Put(ctx context.Context, key string, body io.Reader) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+	Stat(ctx context.Context, key string) (ObjectInfo, error)
+	List(ctx context.Context, prefix string) ([]ObjectInfo, error)
+	SignedURL(ctx context.Context, key string, expiry time.Duration) (string, error)
 }
 
-func exitErrorf(msg string, args ...interface{}) {
-	fmt.Fprintf(os.Stderr, msg+"\n", args...)
-	os.Exit(1)
+type CompletedPart struct {
+	PartNumber int64
+	ETag       string
+}
+
+// MultipartBackend is implemented by backends that accept uploads sent
+// straight from the client in parts, each to its own signed URL.
+type MultipartBackend interface {
+	CreateMultipartUpload(ctx context.Context, key string) (string, error)
+	SignedPartURL(ctx context.Context, key string, uploadId string, partNumber int64, expiry time.Duration) (string, error)
+	ListParts(ctx context.Context, key string, uploadId string) ([]CompletedPart, error)
+	CompleteMultipartUpload(ctx context.Context, key string, uploadId string, parts []CompletedPart) error
+	AbortMultipartUpload(ctx context.Context, key string, uploadId string) error
+}
+
+type Storage struct {
+	Backend
+	// Multipart is nil if the backend does not support multipart uploads
+	Multipart MultipartBackend
+	logger    *zap.Logger
 }
 
 type StorageConnectProps struct {
 	Logger *zap.Logger
 }
 
+// Connect picks the storage backend from STORAGE_BACKEND. "s3" (the default)
+// uses AWS, "minio" uses any S3 compatible server at STORAGE_ENDPOINT and
+// "local" keeps files on disk and serves them through the API server.
 func Connect(args StorageConnectProps) *Storage {
-
-	AWS_ACCESS_KEY_ID := os.Getenv("AWS_ACCESS_KEY_ID")
-	AWS_SECRET_ACCESS_KEY := os.Getenv("AWS_SECRET_ACCESS_KEY")
-	AWS_REGION := os.Getenv("AWS_REGION")
-
-	session, err := session.NewSession(&aws.Config{
-		Region:      aws.String(AWS_REGION),
-		Credentials: credentials.NewStaticCredentials(AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY, ""),
-	})
-	uploader := s3manager.NewUploader(session)
-	s3 := s3.New(session)
+	var backend Backend
+	var err error
+
+	backendType := os.Getenv("STORAGE_BACKEND")
+
+	switch backendType {
+	case "", "s3":
+		backend, err = newS3Backend(s3BackendProps{
+			Bucket:          os.Getenv("AWS_VIDEO_UPLOAD_BUCKET"),
+			Prefix:          getKeyPrefix(),
+			Region:          os.Getenv("AWS_REGION"),
+			AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		})
+	case "minio":
+		endpoint := os.Getenv("STORAGE_ENDPOINT")
+		if endpoint == "" {
+			err = fmt.Errorf("STORAGE_ENDPOINT is required for the minio storage backend")
+			break
+		}
+		backend, err = newS3Backend(s3BackendProps{
+			Bucket:          os.Getenv("AWS_VIDEO_UPLOAD_BUCKET"),
+			Prefix:          getKeyPrefix(),
+			Region:          os.Getenv("AWS_REGION"),
+			AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+			Endpoint:        endpoint,
+		})
+	case "local":
+		backend, err = newLocalBackend(localBackendProps{
+			Root:          os.Getenv("STORAGE_LOCAL_DIR"),
+			PublicURL:     os.Getenv("STORAGE_PUBLIC_URL"),
+			SigningSecret: os.Getenv("STORAGE_SIGNING_SECRET"),
+			Logger:        args.Logger,
+		})
+	default:
+		err = fmt.Errorf("Unknown storage backend %s", backendType)
+	}
 
 	if err != nil {
 		panic(err)
 	}
 
-	//credentials, _ := session.Config.Credentials.Get()
-	args.Logger.Info("AWS Session Started")
-	args.Logger.Info("S3 client started")
+	args.Logger.Info("Storage client started", zap.String("storage_backend", backendType))
+
+	multipart, _ := backend.(MultipartBackend)
 
-	return new(uploader, s3, args.Logger)
+	return &Storage{Backend: backend, Multipart: multipart, logger: args.Logger}
 }
 
-func (s *Storage) Upload(fileName string, file io.ReadSeeker) {
+func getKeyPrefix() string {
+	prefix, ok := os.LookupEnv("STORAGE_KEY_PREFIX")
+	if !ok {
+		prefix = "inputvideos/"
+	}
+	return prefix
+}
 
-	AWS_VIDEO_UPLOAD_BUCKET := os.Getenv("AWS_VIDEO_UPLOAD_BUCKET")
+// SignedURLHandler returns the handler that serves signed URLs when the
+// backend does not have its own file server, nil otherwise.
+func (s *Storage) SignedURLHandler() http.Handler {
+	handler, ok := s.Backend.(http.Handler)
+	if !ok {
+		return nil
+	}
+	return handler
+}
+
+func (s *Storage) Upload(fileName string, file io.ReadSeeker) {
 	file.Seek(0, io.SeekStart)
 
-	//write input to s3
-	_, err := s.uploader.Upload(&s3manager.UploadInput{
-		Bucket: aws.String(AWS_VIDEO_UPLOAD_BUCKET),
-		Body:   file,
-		Key:    aws.String("inputvideos/" + fileName),
-	})
+	err := s.Put(context.Background(), fileName, file)
 
 	if err != nil {
-		s.logger.Error("Unable to upload: "+fileName+" to bucket: "+AWS_VIDEO_UPLOAD_BUCKET, zap.Error(err))
+		s.logger.Error("Unable to upload: "+fileName, zap.Error(err))
 	} else {
-		s.logger.Info("Successfully uploaded: " + fileName + " to bucket: " + AWS_VIDEO_UPLOAD_BUCKET)
+		s.logger.Info("Successfully uploaded: " + fileName)
 	}
-
 }
 
 func (s *Storage) GetFileLink(fileName string) string {
-
-	AWS_VIDEO_UPLOAD_BUCKET := os.Getenv("AWS_VIDEO_UPLOAD_BUCKET")
-
-	request, _ := s.s3.GetObjectRequest(&s3.GetObjectInput{
-		Bucket: aws.String(AWS_VIDEO_UPLOAD_BUCKET),
-		Key:    aws.String("inputvideos/" + fileName),
-	})
-
-	urlStr, err := request.Presign(120 * time.Minute)
+	urlStr, err := s.SignedURL(context.Background(), fileName, 120*time.Minute)
 
 	if err != nil {
 		s.logger.Error("Failed to sign request", zap.Error(err))
@@ -96,18 +152,37 @@ func (s *Storage) GetFileLink(fileName string) string {
 }
 
 func (s *Storage) DeleteFile(fileName string) {
-
-	AWS_VIDEO_UPLOAD_BUCKET := os.Getenv("AWS_VIDEO_UPLOAD_BUCKET")
-
-	_, err := s.s3.DeleteObject(&s3.DeleteObjectInput{
-		Bucket: aws.String(AWS_VIDEO_UPLOAD_BUCKET),
-		Key:    aws.String("inputvideos/" + fileName),
-	})
+	err := s.Delete(context.Background(), fileName)
 
 	if err != nil {
 		s.logger.Error("Failed to delete file", zap.Error(err))
 	} else {
-		s.logger.Info("Deleted " + fileName + " from S3")
+		s.logger.Info("Deleted " + fileName + " from storage")
+	}
+}
+
+// DownloadFile streams an object to filePath.
+func (s *Storage) DownloadFile(ctx context.Context, key string, filePath string) error {
+	body, err := s.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	file, err := os.Create(filePath)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(file, body)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		os.Remove(filePath)
+		return fmt.Errorf("Unable to download %s: %s", key, err.Error())
 	}
 
+	return nil
 }
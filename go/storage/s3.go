@@ -0,0 +1,240 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+type s3BackendProps struct {
+	Bucket          string
+	Prefix          string
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+	// Endpoint points the client at an S3 compatible server such as MinIO
+	Endpoint string
+}
+
+type s3Backend struct {
+	uploader *s3manager.Uploader
+	s3       *s3.S3
+	bucket   string
+	prefix   string
+}
+
+func newS3Backend(args s3BackendProps) (*s3Backend, error) {
+	if args.Bucket == "" {
+		return nil, fmt.Errorf("AWS_VIDEO_UPLOAD_BUCKET is required for the s3 storage backend")
+	}
+
+	config := &aws.Config{
+		Region:      aws.String(args.Region),
+		Credentials: credentials.NewStaticCredentials(args.AccessKeyID, args.SecretAccessKey, ""),
+	}
+
+	if args.Endpoint != "" {
+		if args.Region == "" {
+			config.Region = aws.String("us-east-1")
+		}
+		config.Endpoint = aws.String(args.Endpoint)
+		config.S3ForcePathStyle = aws.Bool(true)
+	}
+
+	session, err := session.NewSession(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &s3Backend{
+		uploader: s3manager.NewUploader(session),
+		s3:       s3.New(session),
+		bucket:   args.Bucket,
+		prefix:   args.Prefix,
+	}, nil
+}
+
+func (b *s3Backend) objectKey(key string) string {
+	return b.prefix + key
+}
+
+func isS3NotFound(err error) bool {
+	if aerr, ok := err.(awserr.Error); ok {
+		return aerr.Code() == s3.ErrCodeNoSuchKey || aerr.Code() == "NotFound"
+	}
+	return false
+}
+
+func (b *s3Backend) Put(ctx context.Context, key string, body io.Reader) error {
+	_, err := b.uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket: aws.String(b.bucket),
+		Body:   body,
+		Key:    aws.String(b.objectKey(key)),
+	})
+	if err != nil {
+		return fmt.Errorf("Unable to upload %s to bucket %s: %s", key, b.bucket, err.Error())
+	}
+	return nil
+}
+
+func (b *s3Backend) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	output, err := b.s3.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(b.objectKey(key)),
+	})
+	if isS3NotFound(err) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Unable to fetch %s from bucket %s: %s", key, b.bucket, err.Error())
+	}
+	return output.Body, nil
+}
+
+func (b *s3Backend) Delete(ctx context.Context, key string) error {
+	_, err := b.s3.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(b.objectKey(key)),
+	})
+	if err != nil {
+		return fmt.Errorf("Unable to delete %s from bucket %s: %s", key, b.bucket, err.Error())
+	}
+	return nil
+}
+
+func (b *s3Backend) Stat(ctx context.Context, key string) (ObjectInfo, error) {
+	output, err := b.s3.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(b.objectKey(key)),
+	})
+	if isS3NotFound(err) {
+		return ObjectInfo{}, ErrNotFound
+	}
+	if err != nil {
+		return ObjectInfo{}, fmt.Errorf("Unable to stat %s in bucket %s: %s", key, b.bucket, err.Error())
+	}
+	return ObjectInfo{
+		Key:          key,
+		Size:         aws.Int64Value(output.ContentLength),
+		LastModified: aws.TimeValue(output.LastModified),
+	}, nil
+}
+
+func (b *s3Backend) List(ctx context.Context, prefix string) ([]ObjectInfo, error) {
+	var objects []ObjectInfo
+
+	err := b.s3.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(b.bucket),
+		Prefix: aws.String(b.objectKey(prefix)),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			objects = append(objects, ObjectInfo{
+				Key:          strings.TrimPrefix(aws.StringValue(object.Key), b.prefix),
+				Size:         aws.Int64Value(object.Size),
+				LastModified: aws.TimeValue(object.LastModified),
+			})
+		}
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Unable to list objects with prefix %s in bucket %s: %s", prefix, b.bucket, err.Error())
+	}
+
+	return objects, nil
+}
+
+func (b *s3Backend) SignedURL(ctx context.Context, key string, expiry time.Duration) (string, error) {
+	request, _ := b.s3.GetObjectRequest(&s3.GetObjectInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(b.objectKey(key)),
+	})
+	request.SetContext(ctx)
+	return request.Presign(expiry)
+}
+
+func (b *s3Backend) CreateMultipartUpload(ctx context.Context, key string) (string, error) {
+	output, err := b.s3.CreateMultipartUploadWithContext(ctx, &s3.CreateMultipartUploadInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(b.objectKey(key)),
+	})
+	if err != nil {
+		return "", fmt.Errorf("Unable to start multipart upload for %s in bucket %s: %s", key, b.bucket, err.Error())
+	}
+	return aws.StringValue(output.UploadId), nil
+}
+
+func (b *s3Backend) SignedPartURL(ctx context.Context, key string, uploadId string, partNumber int64, expiry time.Duration) (string, error) {
+	request, _ := b.s3.UploadPartRequest(&s3.UploadPartInput{
+		Bucket:     aws.String(b.bucket),
+		Key:        aws.String(b.objectKey(key)),
+		UploadId:   aws.String(uploadId),
+		PartNumber: aws.Int64(partNumber),
+	})
+	request.SetContext(ctx)
+	return request.Presign(expiry)
+}
+
+func (b *s3Backend) ListParts(ctx context.Context, key string, uploadId string) ([]CompletedPart, error) {
+	var parts []CompletedPart
+
+	err := b.s3.ListPartsPagesWithContext(ctx, &s3.ListPartsInput{
+		Bucket:   aws.String(b.bucket),
+		Key:      aws.String(b.objectKey(key)),
+		UploadId: aws.String(uploadId),
+	}, func(page *s3.ListPartsOutput, lastPage bool) bool {
+		for _, part := range page.Parts {
+			parts = append(parts, CompletedPart{
+				PartNumber: aws.Int64Value(part.PartNumber),
+				ETag:       aws.StringValue(part.ETag),
+			})
+		}
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Unable to list uploaded parts for %s in bucket %s: %s", key, b.bucket, err.Error())
+	}
+
+	return parts, nil
+}
+
+func (b *s3Backend) CompleteMultipartUpload(ctx context.Context, key string, uploadId string, parts []CompletedPart) error {
+	completedParts := []*s3.CompletedPart{}
+	for _, part := range parts {
+		completedParts = append(completedParts, &s3.CompletedPart{
+			PartNumber: aws.Int64(part.PartNumber),
+			ETag:       aws.String(part.ETag),
+		})
+	}
+
+	_, err := b.s3.CompleteMultipartUploadWithContext(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(b.bucket),
+		Key:             aws.String(b.objectKey(key)),
+		UploadId:        aws.String(uploadId),
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: completedParts},
+	})
+	if err != nil {
+		return fmt.Errorf("Unable to complete multipart upload for %s in bucket %s: %s", key, b.bucket, err.Error())
+	}
+	return nil
+}
+
+func (b *s3Backend) AbortMultipartUpload(ctx context.Context, key string, uploadId string) error {
+	_, err := b.s3.AbortMultipartUploadWithContext(ctx, &s3.AbortMultipartUploadInput{
+		Bucket:   aws.String(b.bucket),
+		Key:      aws.String(b.objectKey(key)),
+		UploadId: aws.String(uploadId),
+	})
+	if err != nil {
+		return fmt.Errorf("Unable to abort multipart upload for %s in bucket %s: %s", key, b.bucket, err.Error())
+	}
+	return nil
+}
@@ -1,11 +1,10 @@
 package utils
 
 import (
-	"bytes"
-	"fmt"
-	"math"
-	"os/exec"
-	"strconv"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
 	"strings"
 	"time"
 )
@@ -16,113 +15,91 @@ func GetCurrentDateTimeString() string {
 	return timeString
 }
 
-func GetAudioFileDuration(fileName string) (float64, error) {
-
-	cmdString := fmt.Sprintf("ffprobe -v error -show_entries format=duration -of default=noprint_wrappers=1:nokey=1 file:'%s'", fileName)
-
-	output, err := ExecCommand(cmdString)
-	if err != nil {
-		return 0, fmt.Errorf("Could not run ffprobe to get duration: %s", err.Error())
-	}
-
-	text := strings.TrimSpace(string(output))
-	duration, err := strconv.ParseFloat(text, 64)
-	if err != nil {
-		return 0, fmt.Errorf("Could not trim space to get duration: %s", err.Error())
+// DeleteFiles removes files from disk. Missing files are ignored, the first
+// other error is returned after trying every file.
+func DeleteFiles(fileNames []string) error {
+	var firstErr error
+	for _, fileName := range fileNames {
+		err := os.Remove(fileName)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) && firstErr == nil {
+			firstErr = err
+		}
 	}
-
-	return duration, nil
-
+	return firstErr
 }
 
-func GetVideoFileFrameRate(fileName string) (float64, error) {
-	cmdString := fmt.Sprintf("ffprobe -v error -select_streams v:0 -show_entries stream=r_frame_rate -of default=noprint_wrappers=1:nokey=1 file:'%s'", fileName)
-
-	output, err := ExecCommand(cmdString)
-	if err != nil {
-		return 0, fmt.Errorf("Could not run ffprobe to get frame rate: %s", err.Error())
-	}
-	frameRateString := strings.TrimSpace(output)
-	frameRate, err := parseFrameRateString(frameRateString)
+func MinOf(vars ...int) int {
+	min := vars[0]
 
-	if err != nil {
-		return 0, fmt.Errorf("Could not get the frame rate: %s, %s", frameRateString, err.Error())
+	for _, i := range vars {
+		if min > i {
+			min = i
+		}
 	}
-
-	return frameRate, nil
+	return min
 }
 
-func parseFrameRateString(frameRateString string) (float64, error) {
-	if !strings.Contains(frameRateString, "/") {
-		return strconv.ParseFloat(frameRateString, 64)
-	}
+func MaxOf(vars ...int) int {
+	max := vars[0]
 
-	parts := strings.Split(frameRateString, "/")
-	if len(parts) != 2 {
-		return 0, fmt.Errorf("Unexpected frame rate string: %s", frameRateString)
+	for _, i := range vars {
+		if max < i {
+			max = i
+		}
 	}
 
-	numerator, err := strconv.ParseFloat(parts[0], 64)
-	if err != nil {
-		return 0, fmt.Errorf("Could not parse numerator: %s", err.Error())
-	}
+	return max
+}
 
-	denominator, err := strconv.ParseFloat(parts[1], 64)
-	if err != nil {
-		return 0, fmt.Errorf("Could not parse denominator: %s", err.Error())
-	}
+func Max64(vars ...int64) int64 {
+	max := vars[0]
 
-	if denominator == 0 {
-		return 0, fmt.Errorf("Denominator in frame rate fraction is 0")
+	for _, i := range vars {
+		if max < i {
+			max = i
+		}
 	}
 
-	return numerator / denominator, nil
+	return max
 }
 
-func DeleteFiles(fileNames []string) (string, error) {
-	fileNameString := strings.Join(fileNames, " ")
-	removeCmd := fmt.Sprintf("rm -rf %s", fileNameString)
-	return ExecCommand(removeCmd)
+// TempFile is a file on disk that is deleted when it is closed. Media is
+// passed around as a TempFile so it never has to be held in memory.
+type TempFile struct {
+	*os.File
 }
 
-func ExecCommand(command string) (string, error) {
-	cmd := exec.Command("sh", "-c", command)
-
-	var stderr bytes.Buffer
-	cmd.Stderr = &stderr
-	output, err := cmd.Output()
-	errMsg := stderr.String()
-
+// CreateTempFile writes data to a new file on disk and returns it as a
+// TempFile, positioned at the start.
+func CreateTempFile(fileName string, data io.Reader) (*TempFile, error) {
+	file, err := os.Create(fileName)
 	if err != nil {
-		return "", fmt.Errorf("Command failed: %s, %s", err.Error(), errMsg)
+		return nil, err
 	}
-	return string(output), nil
-}
-
-func MinOf(vars ...int) int {
-	min := vars[0]
 
-	for _, i := range vars {
-		if min > i {
-			min = i
-		}
+	_, err = io.Copy(file, data)
+	if err == nil {
+		_, err = file.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		file.Close()
+		os.Remove(fileName)
+		return nil, err
 	}
-	return min
-}
 
-func MaxOf(vars ...int) int {
-	max := vars[0]
+	return &TempFile{File: file}, nil
+}
 
-	for _, i := range vars {
-		if max < i {
-			max = i
-		}
+func OpenTempFile(fileName string) (*TempFile, error) {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return nil, err
 	}
-
-	return max
+	return &TempFile{File: file}, nil
 }
 
-func GetExponentialDelaySeconds(retryNumber int) int {
-	delayTime := int(5 * math.Pow(2, float64(retryNumber)))
-	return delayTime
+func (t *TempFile) Close() error {
+	err := t.File.Close()
+	os.Remove(t.File.Name())
+	return err
 }
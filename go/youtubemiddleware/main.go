@@ -1,11 +1,8 @@
 package youtubemiddleware
 
 import (
-	"bytes"
 	"context"
 	"fmt"
-	"io"
-	"os"
 	"planetcastdev/ffmpegmiddleware"
 	"planetcastdev/utils"
 
@@ -43,7 +40,7 @@ func (y *Youtube) GetVideoInfo(videoUrl string) (*youtube.Video, error) {
 	return video, err
 }
 
-func (y *Youtube) downloadVideo(video *youtube.Video) (io.ReadSeeker, error) {
+func (y *Youtube) downloadVideo(video *youtube.Video, profile ffmpegmiddleware.OutputProfile) (*utils.TempFile, error) {
 	newCtx := context.Background()
 	randomString := uuid.NewString()
 
@@ -61,32 +58,24 @@ func (y *Youtube) downloadVideo(video *youtube.Video) (io.ReadSeeker, error) {
 	}
 	y.logger.Info("Downloaded youtube file successfully", zap.String("video_id", video.ID), zap.String("file_name", randomFileName))
 
-	file, err := os.Open(randomFileName)
+	file, err := utils.OpenTempFile(randomFileName)
 	if err != nil {
 		y.logger.Error("Could not open downloaded youtube video", zap.Error(err), zap.String("video_id", video.ID), zap.String("file_name", randomFileName))
 		return nil, err
 	}
 	defer file.Close()
 
-	fileContent, err := io.ReadAll(file)
-	if err != nil {
-		y.logger.Error("Could not read opened downloaded youtube video", zap.Error(err), zap.String("video_id", video.ID), zap.String("file_name", randomFileName))
-		return nil, err
-	}
-	seeker := bytes.NewReader(fileContent)
-
-	readSeeker, err := y.ffmpeg.DownscaleFile(newCtx, seeker)
-	utils.DeleteFiles([]string{randomFileName})
+	encodedFile, err := y.ffmpeg.EncodeFile(newCtx, file.File, profile)
 
 	if err != nil {
-		y.logger.Error("Could not downscale downloaded youtube video", zap.Error(err), zap.String("video_id", video.ID), zap.String("file_name", randomFileName))
+		y.logger.Error("Could not encode downloaded youtube video", zap.Error(err), zap.String("video_id", video.ID), zap.String("file_name", randomFileName))
 		return nil, err
 	}
 
-	return readSeeker, nil
+	return encodedFile, nil
 }
 
-func (y *Youtube) Download(videoUrl string) (io.ReadSeeker, string, error) {
+func (y *Youtube) Download(videoUrl string, profile ffmpegmiddleware.OutputProfile) (*utils.TempFile, string, error) {
 	video, err := y.GetVideoInfo(videoUrl)
 	if err != nil {
 		return nil, "", err
@@ -96,7 +85,7 @@ func (y *Youtube) Download(videoUrl string) (io.ReadSeeker, string, error) {
 		return nil, "", fmt.Errorf("video longer than 90 minutes, please provide a video under the 90 minute limit")
 	}
 
-	file, err := y.downloadVideo(video)
+	file, err := y.downloadVideo(video, profile)
 	if err != nil {
 		return nil, "", err
 	}